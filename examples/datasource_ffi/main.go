@@ -47,7 +47,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("ExecuteDataSource error: %v", err)
 	}
-	fmt.Printf("ExecuteDataSource: Success=%v, Message=%s\n", execResp.Success, execResp.Message)
+	fmt.Printf("ExecuteDataSource: Success=%v, AffectedRows=%d, Message=%s\n", execResp.Success, execResp.AffectedRows, execResp.Message)
 
 	// 4. Insert data
 	insertData := map[string]interface{}{
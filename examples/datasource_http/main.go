@@ -48,7 +48,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to execute: %v", err)
 	}
-	fmt.Printf("✅ Execute Success: %v, Message: %s\n", execResp.Success, execResp.Message)
+	fmt.Printf("✅ Execute Success: %v, Affected rows: %d, Message: %s\n", execResp.Success, execResp.AffectedRows, execResp.Message)
 
 	// Example 4: Insert data
 	fmt.Println("\n=== Inserting Data ===")
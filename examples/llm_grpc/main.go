@@ -38,9 +38,9 @@ func main() {
 	fmt.Printf("Generated text: %s\n", generateResp.Text)
 
 	// 3. Chat conversation
-	messages := []map[string]interface{}{
-		{"role": "system", "content": "You are a helpful assistant."},
-		{"role": "user", "content": "What is the capital of France?"},
+	messages := []operrouter.ChatMessage{
+		operrouter.SystemMessage("You are a helpful assistant."),
+		operrouter.UserMessage("What is the capital of France?"),
 	}
 	chatResp, err := client.ChatLLM(ctx, "my_llm", messages)
 	if err != nil {
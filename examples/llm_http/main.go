@@ -37,18 +37,13 @@ func main() {
 	}
 	fmt.Printf("✅ Generate Success: %v\n", genResp.Success)
 	fmt.Printf("   Text: %s\n", genResp.Text)
+	fmt.Printf("   Model: %s, Tokens: %d, Finish reason: %s\n", genResp.Model, genResp.TokensUsed, genResp.FinishReason)
 
 	// Example 3: Chat with message history
 	fmt.Println("\n=== Chat Conversation ===")
-	messages := []map[string]interface{}{
-		{
-			"role":    "system",
-			"content": "You are a helpful assistant.",
-		},
-		{
-			"role":    "user",
-			"content": "What is the capital of France?",
-		},
+	messages := []operrouter.ChatMessage{
+		operrouter.SystemMessage("You are a helpful assistant."),
+		operrouter.UserMessage("What is the capital of France?"),
 	}
 	chatResp, err := client.ChatLLM(ctx, "my_llm", messages)
 	if err != nil {
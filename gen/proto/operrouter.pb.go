@@ -79,7 +79,165 @@ func (DataSourceType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{0}
 }
 
-// LLM operations
+type IsolationLevel int32
+
+const (
+	IsolationLevel_ISOLATION_LEVEL_UNSPECIFIED      IsolationLevel = 0
+	IsolationLevel_ISOLATION_LEVEL_READ_UNCOMMITTED IsolationLevel = 1
+	IsolationLevel_ISOLATION_LEVEL_READ_COMMITTED   IsolationLevel = 2
+	IsolationLevel_ISOLATION_LEVEL_REPEATABLE_READ  IsolationLevel = 3
+	IsolationLevel_ISOLATION_LEVEL_SERIALIZABLE     IsolationLevel = 4
+)
+
+// Enum value maps for IsolationLevel.
+var (
+	IsolationLevel_name = map[int32]string{
+		0: "ISOLATION_LEVEL_UNSPECIFIED",
+		1: "ISOLATION_LEVEL_READ_UNCOMMITTED",
+		2: "ISOLATION_LEVEL_READ_COMMITTED",
+		3: "ISOLATION_LEVEL_REPEATABLE_READ",
+		4: "ISOLATION_LEVEL_SERIALIZABLE",
+	}
+	IsolationLevel_value = map[string]int32{
+		"ISOLATION_LEVEL_UNSPECIFIED":      0,
+		"ISOLATION_LEVEL_READ_UNCOMMITTED": 1,
+		"ISOLATION_LEVEL_READ_COMMITTED":   2,
+		"ISOLATION_LEVEL_REPEATABLE_READ":  3,
+		"ISOLATION_LEVEL_SERIALIZABLE":     4,
+	}
+)
+
+func (x IsolationLevel) Enum() *IsolationLevel {
+	p := new(IsolationLevel)
+	*p = x
+	return p
+}
+
+func (x IsolationLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IsolationLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_operrouter_proto_enumTypes[1].Descriptor()
+}
+
+func (IsolationLevel) Type() protoreflect.EnumType {
+	return &file_proto_operrouter_proto_enumTypes[1]
+}
+
+func (x IsolationLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IsolationLevel.Descriptor instead.
+func (IsolationLevel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{1}
+}
+
+type TableKind int32
+
+const (
+	TableKind_TABLE_KIND_UNSPECIFIED TableKind = 0
+	TableKind_TABLE_KIND_TABLE       TableKind = 1
+	TableKind_TABLE_KIND_VIEW        TableKind = 2
+	TableKind_TABLE_KIND_COLLECTION  TableKind = 3
+)
+
+// Enum value maps for TableKind.
+var (
+	TableKind_name = map[int32]string{
+		0: "TABLE_KIND_UNSPECIFIED",
+		1: "TABLE_KIND_TABLE",
+		2: "TABLE_KIND_VIEW",
+		3: "TABLE_KIND_COLLECTION",
+	}
+	TableKind_value = map[string]int32{
+		"TABLE_KIND_UNSPECIFIED": 0,
+		"TABLE_KIND_TABLE":       1,
+		"TABLE_KIND_VIEW":        2,
+		"TABLE_KIND_COLLECTION":  3,
+	}
+)
+
+func (x TableKind) Enum() *TableKind {
+	p := new(TableKind)
+	*p = x
+	return p
+}
+
+func (x TableKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TableKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_operrouter_proto_enumTypes[2].Descriptor()
+}
+
+func (TableKind) Type() protoreflect.EnumType {
+	return &file_proto_operrouter_proto_enumTypes[2]
+}
+
+func (x TableKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TableKind.Descriptor instead.
+func (TableKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{2}
+}
+
+type KafkaAcks int32
+
+const (
+	KafkaAcks_KAFKA_ACKS_UNSPECIFIED KafkaAcks = 0
+	KafkaAcks_KAFKA_ACKS_NONE        KafkaAcks = 1
+	KafkaAcks_KAFKA_ACKS_LEADER      KafkaAcks = 2
+	KafkaAcks_KAFKA_ACKS_ALL         KafkaAcks = 3
+)
+
+// Enum value maps for KafkaAcks.
+var (
+	KafkaAcks_name = map[int32]string{
+		0: "KAFKA_ACKS_UNSPECIFIED",
+		1: "KAFKA_ACKS_NONE",
+		2: "KAFKA_ACKS_LEADER",
+		3: "KAFKA_ACKS_ALL",
+	}
+	KafkaAcks_value = map[string]int32{
+		"KAFKA_ACKS_UNSPECIFIED": 0,
+		"KAFKA_ACKS_NONE":        1,
+		"KAFKA_ACKS_LEADER":      2,
+		"KAFKA_ACKS_ALL":         3,
+	}
+)
+
+func (x KafkaAcks) Enum() *KafkaAcks {
+	p := new(KafkaAcks)
+	*p = x
+	return p
+}
+
+func (x KafkaAcks) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KafkaAcks) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_operrouter_proto_enumTypes[3].Descriptor()
+}
+
+func (KafkaAcks) Type() protoreflect.EnumType {
+	return &file_proto_operrouter_proto_enumTypes[3]
+}
+
+func (x KafkaAcks) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KafkaAcks.Descriptor instead.
+func (KafkaAcks) EnumDescriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{3}
+}
+
 type LLMProvider int32
 
 const (
@@ -119,11 +277,11 @@ func (x LLMProvider) String() string {
 }
 
 func (LLMProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_operrouter_proto_enumTypes[1].Descriptor()
+	return file_proto_operrouter_proto_enumTypes[4].Descriptor()
 }
 
 func (LLMProvider) Type() protoreflect.EnumType {
-	return &file_proto_operrouter_proto_enumTypes[1]
+	return &file_proto_operrouter_proto_enumTypes[4]
 }
 
 func (x LLMProvider) Number() protoreflect.EnumNumber {
@@ -132,7 +290,7 @@ func (x LLMProvider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LLMProvider.Descriptor instead.
 func (LLMProvider) EnumDescriptor() ([]byte, []int) {
-	return file_proto_operrouter_proto_rawDescGZIP(), []int{1}
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{4}
 }
 
 type MessageRole int32
@@ -142,6 +300,7 @@ const (
 	MessageRole_MESSAGE_ROLE_SYSTEM      MessageRole = 1
 	MessageRole_MESSAGE_ROLE_USER        MessageRole = 2
 	MessageRole_MESSAGE_ROLE_ASSISTANT   MessageRole = 3
+	MessageRole_MESSAGE_ROLE_TOOL        MessageRole = 4
 )
 
 // Enum value maps for MessageRole.
@@ -151,12 +310,14 @@ var (
 		1: "MESSAGE_ROLE_SYSTEM",
 		2: "MESSAGE_ROLE_USER",
 		3: "MESSAGE_ROLE_ASSISTANT",
+		4: "MESSAGE_ROLE_TOOL",
 	}
 	MessageRole_value = map[string]int32{
 		"MESSAGE_ROLE_UNSPECIFIED": 0,
 		"MESSAGE_ROLE_SYSTEM":      1,
 		"MESSAGE_ROLE_USER":        2,
 		"MESSAGE_ROLE_ASSISTANT":   3,
+		"MESSAGE_ROLE_TOOL":        4,
 	}
 )
 
@@ -171,11 +332,11 @@ func (x MessageRole) String() string {
 }
 
 func (MessageRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_operrouter_proto_enumTypes[2].Descriptor()
+	return file_proto_operrouter_proto_enumTypes[5].Descriptor()
 }
 
 func (MessageRole) Type() protoreflect.EnumType {
-	return &file_proto_operrouter_proto_enumTypes[2]
+	return &file_proto_operrouter_proto_enumTypes[5]
 }
 
 func (x MessageRole) Number() protoreflect.EnumNumber {
@@ -184,7 +345,61 @@ func (x MessageRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageRole.Descriptor instead.
 func (MessageRole) EnumDescriptor() ([]byte, []int) {
-	return file_proto_operrouter_proto_rawDescGZIP(), []int{2}
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{5}
+}
+
+type ResponseFormatType int32
+
+const (
+	ResponseFormatType_RESPONSE_FORMAT_TYPE_UNSPECIFIED ResponseFormatType = 0
+	ResponseFormatType_RESPONSE_FORMAT_TYPE_TEXT        ResponseFormatType = 1
+	// Any JSON object
+	ResponseFormatType_RESPONSE_FORMAT_TYPE_JSON_OBJECT ResponseFormatType = 2
+	// JSON matching schema_json
+	ResponseFormatType_RESPONSE_FORMAT_TYPE_JSON_SCHEMA ResponseFormatType = 3
+)
+
+// Enum value maps for ResponseFormatType.
+var (
+	ResponseFormatType_name = map[int32]string{
+		0: "RESPONSE_FORMAT_TYPE_UNSPECIFIED",
+		1: "RESPONSE_FORMAT_TYPE_TEXT",
+		2: "RESPONSE_FORMAT_TYPE_JSON_OBJECT",
+		3: "RESPONSE_FORMAT_TYPE_JSON_SCHEMA",
+	}
+	ResponseFormatType_value = map[string]int32{
+		"RESPONSE_FORMAT_TYPE_UNSPECIFIED": 0,
+		"RESPONSE_FORMAT_TYPE_TEXT":        1,
+		"RESPONSE_FORMAT_TYPE_JSON_OBJECT": 2,
+		"RESPONSE_FORMAT_TYPE_JSON_SCHEMA": 3,
+	}
+)
+
+func (x ResponseFormatType) Enum() *ResponseFormatType {
+	p := new(ResponseFormatType)
+	*p = x
+	return p
+}
+
+func (x ResponseFormatType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseFormatType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_operrouter_proto_enumTypes[6].Descriptor()
+}
+
+func (ResponseFormatType) Type() protoreflect.EnumType {
+	return &file_proto_operrouter_proto_enumTypes[6]
+}
+
+func (x ResponseFormatType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseFormatType.Descriptor instead.
+func (ResponseFormatType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{6}
 }
 
 // Common types
@@ -1147,6 +1362,12 @@ type QueryDataSourceRequest struct {
 
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Positional bind arguments ($1 for PostgreSQL, ? for MySQL)
+	Args []*Value `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Named bind arguments
+	NamedArgs map[string]*Value `protobuf:"bytes,4,rep,name=named_args,json=namedArgs,proto3" json:"named_args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Runs the statement inside this transaction when set
+	TransactionId string `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
 func (x *QueryDataSourceRequest) Reset() {
@@ -1195,6 +1416,27 @@ func (x *QueryDataSourceRequest) GetQuery() string {
 	return ""
 }
 
+func (x *QueryDataSourceRequest) GetArgs() []*Value {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *QueryDataSourceRequest) GetNamedArgs() map[string]*Value {
+	if x != nil {
+		return x.NamedArgs
+	}
+	return nil
+}
+
+func (x *QueryDataSourceRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
 type QueryDataSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1203,6 +1445,8 @@ type QueryDataSourceResponse struct {
 	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Rows    []*Row `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
 	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Column names in select-list order; rows are maps and carry no order
+	Columns []string `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
 }
 
 func (x *QueryDataSourceResponse) Reset() {
@@ -1258,17 +1502,28 @@ func (x *QueryDataSourceResponse) GetError() string {
 	return ""
 }
 
-type ExecuteDataSourceRequest struct {
+func (x *QueryDataSourceResponse) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type StreamQueryDataSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query     string            `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Args      []*Value          `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	NamedArgs map[string]*Value `protobuf:"bytes,4,rep,name=named_args,json=namedArgs,proto3" json:"named_args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Rows per message; 0 lets the server choose
+	PageSize uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *ExecuteDataSourceRequest) Reset() {
-	*x = ExecuteDataSourceRequest{}
+func (x *StreamQueryDataSourceRequest) Reset() {
+	*x = StreamQueryDataSourceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1276,13 +1531,13 @@ func (x *ExecuteDataSourceRequest) Reset() {
 	}
 }
 
-func (x *ExecuteDataSourceRequest) String() string {
+func (x *StreamQueryDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteDataSourceRequest) ProtoMessage() {}
+func (*StreamQueryDataSourceRequest) ProtoMessage() {}
 
-func (x *ExecuteDataSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamQueryDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,37 +1549,60 @@ func (x *ExecuteDataSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteDataSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamQueryDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*StreamQueryDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ExecuteDataSourceRequest) GetName() string {
+func (x *StreamQueryDataSourceRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ExecuteDataSourceRequest) GetQuery() string {
+func (x *StreamQueryDataSourceRequest) GetQuery() string {
 	if x != nil {
 		return x.Query
 	}
 	return ""
 }
 
-type ExecuteDataSourceResponse struct {
+func (x *StreamQueryDataSourceRequest) GetArgs() []*Value {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *StreamQueryDataSourceRequest) GetNamedArgs() map[string]*Value {
+	if x != nil {
+		return x.NamedArgs
+	}
+	return nil
+}
+
+func (x *StreamQueryDataSourceRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type StreamQueryDataSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	AffectedRows uint64 `protobuf:"varint,2,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
-	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Rows    []*Row `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Set on the last message
+	Done  bool   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ExecuteDataSourceResponse) Reset() {
-	*x = ExecuteDataSourceResponse{}
+func (x *StreamQueryDataSourceResponse) Reset() {
+	*x = StreamQueryDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1332,13 +1610,13 @@ func (x *ExecuteDataSourceResponse) Reset() {
 	}
 }
 
-func (x *ExecuteDataSourceResponse) String() string {
+func (x *StreamQueryDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteDataSourceResponse) ProtoMessage() {}
+func (*StreamQueryDataSourceResponse) ProtoMessage() {}
 
-func (x *ExecuteDataSourceResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamQueryDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,44 +1628,56 @@ func (x *ExecuteDataSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteDataSourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamQueryDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*StreamQueryDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ExecuteDataSourceResponse) GetSuccess() bool {
+func (x *StreamQueryDataSourceResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ExecuteDataSourceResponse) GetAffectedRows() uint64 {
+func (x *StreamQueryDataSourceResponse) GetRows() []*Row {
 	if x != nil {
-		return x.AffectedRows
+		return x.Rows
 	}
-	return 0
+	return nil
 }
 
-func (x *ExecuteDataSourceResponse) GetError() string {
+func (x *StreamQueryDataSourceResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *StreamQueryDataSourceResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type InsertDataSourceRequest struct {
+type ExecuteDataSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
-	Data  *Row   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Positional bind arguments ($1 for PostgreSQL, ? for MySQL)
+	Args []*Value `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Named bind arguments
+	NamedArgs map[string]*Value `protobuf:"bytes,4,rep,name=named_args,json=namedArgs,proto3" json:"named_args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Runs the statement inside this transaction when set
+	TransactionId string `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *InsertDataSourceRequest) Reset() {
-	*x = InsertDataSourceRequest{}
+func (x *ExecuteDataSourceRequest) Reset() {
+	*x = ExecuteDataSourceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1395,13 +1685,13 @@ func (x *InsertDataSourceRequest) Reset() {
 	}
 }
 
-func (x *InsertDataSourceRequest) String() string {
+func (x *ExecuteDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertDataSourceRequest) ProtoMessage() {}
+func (*ExecuteDataSourceRequest) ProtoMessage() {}
 
-func (x *InsertDataSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1413,44 +1703,62 @@ func (x *InsertDataSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*InsertDataSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *InsertDataSourceRequest) GetName() string {
+func (x *ExecuteDataSourceRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *InsertDataSourceRequest) GetTable() string {
+func (x *ExecuteDataSourceRequest) GetQuery() string {
 	if x != nil {
-		return x.Table
+		return x.Query
 	}
 	return ""
 }
 
-func (x *InsertDataSourceRequest) GetData() *Row {
+func (x *ExecuteDataSourceRequest) GetArgs() []*Value {
 	if x != nil {
-		return x.Data
+		return x.Args
 	}
 	return nil
 }
 
-type InsertDataSourceResponse struct {
+func (x *ExecuteDataSourceRequest) GetNamedArgs() map[string]*Value {
+	if x != nil {
+		return x.NamedArgs
+	}
+	return nil
+}
+
+func (x *ExecuteDataSourceRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type ExecuteDataSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	InsertedRows uint64 `protobuf:"varint,2,opt,name=inserted_rows,json=insertedRows,proto3" json:"inserted_rows,omitempty"`
+	AffectedRows uint64 `protobuf:"varint,2,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
 	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Set only when the driver reports it (e.g. MySQL AUTO_INCREMENT)
+	LastInsertId *int64 `protobuf:"varint,4,opt,name=last_insert_id,json=lastInsertId,proto3,oneof" json:"last_insert_id,omitempty"`
+	// Rows returned by the statement (RETURNING, or the reply of a Redis command)
+	Rows []*Row `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
 }
 
-func (x *InsertDataSourceResponse) Reset() {
-	*x = InsertDataSourceResponse{}
+func (x *ExecuteDataSourceResponse) Reset() {
+	*x = ExecuteDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1458,13 +1766,13 @@ func (x *InsertDataSourceResponse) Reset() {
 	}
 }
 
-func (x *InsertDataSourceResponse) String() string {
+func (x *ExecuteDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertDataSourceResponse) ProtoMessage() {}
+func (*ExecuteDataSourceResponse) ProtoMessage() {}
 
-func (x *InsertDataSourceResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,42 +1784,63 @@ func (x *InsertDataSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*InsertDataSourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *InsertDataSourceResponse) GetSuccess() bool {
+func (x *ExecuteDataSourceResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *InsertDataSourceResponse) GetInsertedRows() uint64 {
+func (x *ExecuteDataSourceResponse) GetAffectedRows() uint64 {
 	if x != nil {
-		return x.InsertedRows
+		return x.AffectedRows
 	}
 	return 0
 }
 
-func (x *InsertDataSourceResponse) GetError() string {
+func (x *ExecuteDataSourceResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type PingDataSourceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (x *ExecuteDataSourceResponse) GetLastInsertId() int64 {
+	if x != nil && x.LastInsertId != nil {
+		return *x.LastInsertId
+	}
+	return 0
 }
 
-func (x *PingDataSourceRequest) Reset() {
-	*x = PingDataSourceRequest{}
+func (x *ExecuteDataSourceResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type InsertDataSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Table or collection to insert into
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Data  *Row   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Rows inserted in one call; data is ignored when rows is set
+	Rows []*Row `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Runs the insert inside this transaction when set
+	TransactionId string `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *InsertDataSourceRequest) Reset() {
+	*x = InsertDataSourceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1519,13 +1848,13 @@ func (x *PingDataSourceRequest) Reset() {
 	}
 }
 
-func (x *PingDataSourceRequest) String() string {
+func (x *InsertDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingDataSourceRequest) ProtoMessage() {}
+func (*InsertDataSourceRequest) ProtoMessage() {}
 
-func (x *PingDataSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *InsertDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,29 +1866,58 @@ func (x *PingDataSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*PingDataSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*InsertDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *PingDataSourceRequest) GetName() string {
+func (x *InsertDataSourceRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type PingDataSourceResponse struct {
+func (x *InsertDataSourceRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *InsertDataSourceRequest) GetData() *Row {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InsertDataSourceRequest) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *InsertDataSourceRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type InsertDataSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Healthy bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	InsertedRows uint64 `protobuf:"varint,2,opt,name=inserted_rows,json=insertedRows,proto3" json:"inserted_rows,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *PingDataSourceResponse) Reset() {
-	*x = PingDataSourceResponse{}
+func (x *InsertDataSourceResponse) Reset() {
+	*x = InsertDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1567,13 +1925,13 @@ func (x *PingDataSourceResponse) Reset() {
 	}
 }
 
-func (x *PingDataSourceResponse) String() string {
+func (x *InsertDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingDataSourceResponse) ProtoMessage() {}
+func (*InsertDataSourceResponse) ProtoMessage() {}
 
-func (x *PingDataSourceResponse) ProtoReflect() protoreflect.Message {
+func (x *InsertDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1585,26 +1943,33 @@ func (x *PingDataSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*PingDataSourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InsertDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*InsertDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PingDataSourceResponse) GetHealthy() bool {
+func (x *InsertDataSourceResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Healthy
+		return x.Success
 	}
 	return false
 }
 
-func (x *PingDataSourceResponse) GetError() string {
+func (x *InsertDataSourceResponse) GetInsertedRows() uint64 {
+	if x != nil {
+		return x.InsertedRows
+	}
+	return 0
+}
+
+func (x *InsertDataSourceResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CloseDataSourceRequest struct {
+type PingDataSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1612,8 +1977,8 @@ type CloseDataSourceRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CloseDataSourceRequest) Reset() {
-	*x = CloseDataSourceRequest{}
+func (x *PingDataSourceRequest) Reset() {
+	*x = PingDataSourceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,13 +1986,13 @@ func (x *CloseDataSourceRequest) Reset() {
 	}
 }
 
-func (x *CloseDataSourceRequest) String() string {
+func (x *PingDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseDataSourceRequest) ProtoMessage() {}
+func (*PingDataSourceRequest) ProtoMessage() {}
 
-func (x *CloseDataSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *PingDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1639,29 +2004,29 @@ func (x *CloseDataSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*CloseDataSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PingDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*PingDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CloseDataSourceRequest) GetName() string {
+func (x *PingDataSourceRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type CloseDataSourceResponse struct {
+type PingDataSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Healthy bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
 	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *CloseDataSourceResponse) Reset() {
-	*x = CloseDataSourceResponse{}
+func (x *PingDataSourceResponse) Reset() {
+	*x = PingDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1669,13 +2034,13 @@ func (x *CloseDataSourceResponse) Reset() {
 	}
 }
 
-func (x *CloseDataSourceResponse) String() string {
+func (x *PingDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseDataSourceResponse) ProtoMessage() {}
+func (*PingDataSourceResponse) ProtoMessage() {}
 
-func (x *CloseDataSourceResponse) ProtoReflect() protoreflect.Message {
+func (x *PingDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1687,41 +2052,35 @@ func (x *CloseDataSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*CloseDataSourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PingDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*PingDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CloseDataSourceResponse) GetSuccess() bool {
+func (x *PingDataSourceResponse) GetHealthy() bool {
 	if x != nil {
-		return x.Success
+		return x.Healthy
 	}
 	return false
 }
 
-func (x *CloseDataSourceResponse) GetError() string {
+func (x *PingDataSourceResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type LLMConfig struct {
+type CloseDataSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider       LLMProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=operrouter.v1.LLMProvider" json:"provider,omitempty"`
-	ApiKey         *string     `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,oneof" json:"api_key,omitempty"`
-	ApiBase        *string     `protobuf:"bytes,3,opt,name=api_base,json=apiBase,proto3,oneof" json:"api_base,omitempty"`
-	Model          string      `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
-	Temperature    *float32    `protobuf:"fixed32,5,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
-	MaxTokens      *uint32     `protobuf:"varint,6,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
-	TimeoutSeconds *uint64     `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *LLMConfig) Reset() {
-	*x = LLMConfig{}
+func (x *CloseDataSourceRequest) Reset() {
+	*x = CloseDataSourceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1729,13 +2088,13 @@ func (x *LLMConfig) Reset() {
 	}
 }
 
-func (x *LLMConfig) String() string {
+func (x *CloseDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LLMConfig) ProtoMessage() {}
+func (*CloseDataSourceRequest) ProtoMessage() {}
 
-func (x *LLMConfig) ProtoReflect() protoreflect.Message {
+func (x *CloseDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1747,71 +2106,29 @@ func (x *LLMConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LLMConfig.ProtoReflect.Descriptor instead.
-func (*LLMConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*CloseDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *LLMConfig) GetProvider() LLMProvider {
-	if x != nil {
-		return x.Provider
-	}
-	return LLMProvider_LLM_PROVIDER_UNSPECIFIED
-}
-
-func (x *LLMConfig) GetApiKey() string {
-	if x != nil && x.ApiKey != nil {
-		return *x.ApiKey
-	}
-	return ""
-}
-
-func (x *LLMConfig) GetApiBase() string {
-	if x != nil && x.ApiBase != nil {
-		return *x.ApiBase
-	}
-	return ""
-}
-
-func (x *LLMConfig) GetModel() string {
+func (x *CloseDataSourceRequest) GetName() string {
 	if x != nil {
-		return x.Model
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LLMConfig) GetTemperature() float32 {
-	if x != nil && x.Temperature != nil {
-		return *x.Temperature
-	}
-	return 0
-}
-
-func (x *LLMConfig) GetMaxTokens() uint32 {
-	if x != nil && x.MaxTokens != nil {
-		return *x.MaxTokens
-	}
-	return 0
-}
-
-func (x *LLMConfig) GetTimeoutSeconds() uint64 {
-	if x != nil && x.TimeoutSeconds != nil {
-		return *x.TimeoutSeconds
-	}
-	return 0
-}
-
-type LLMMessage struct {
+type CloseDataSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role    MessageRole `protobuf:"varint,1,opt,name=role,proto3,enum=operrouter.v1.MessageRole" json:"role,omitempty"`
-	Content string      `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *LLMMessage) Reset() {
-	*x = LLMMessage{}
+func (x *CloseDataSourceResponse) Reset() {
+	*x = CloseDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1819,13 +2136,13 @@ func (x *LLMMessage) Reset() {
 	}
 }
 
-func (x *LLMMessage) String() string {
+func (x *CloseDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LLMMessage) ProtoMessage() {}
+func (*CloseDataSourceResponse) ProtoMessage() {}
 
-func (x *LLMMessage) ProtoReflect() protoreflect.Message {
+func (x *CloseDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,36 +2154,37 @@ func (x *LLMMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LLMMessage.ProtoReflect.Descriptor instead.
-func (*LLMMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*CloseDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *LLMMessage) GetRole() MessageRole {
+func (x *CloseDataSourceResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Role
+		return x.Success
 	}
-	return MessageRole_MESSAGE_ROLE_UNSPECIFIED
+	return false
 }
 
-func (x *LLMMessage) GetContent() string {
+func (x *CloseDataSourceResponse) GetError() string {
 	if x != nil {
-		return x.Content
+		return x.Error
 	}
 	return ""
 }
 
-type CreateLLMRequest struct {
+type BeginTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Config *LLMConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Name      string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Isolation IsolationLevel `protobuf:"varint,2,opt,name=isolation,proto3,enum=operrouter.v1.IsolationLevel" json:"isolation,omitempty"`
+	ReadOnly  bool           `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
 }
 
-func (x *CreateLLMRequest) Reset() {
-	*x = CreateLLMRequest{}
+func (x *BeginTransactionRequest) Reset() {
+	*x = BeginTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1874,13 +2192,13 @@ func (x *CreateLLMRequest) Reset() {
 	}
 }
 
-func (x *CreateLLMRequest) String() string {
+func (x *BeginTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLLMRequest) ProtoMessage() {}
+func (*BeginTransactionRequest) ProtoMessage() {}
 
-func (x *CreateLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *BeginTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,36 +2210,44 @@ func (x *CreateLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLLMRequest.ProtoReflect.Descriptor instead.
-func (*CreateLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginTransactionRequest.ProtoReflect.Descriptor instead.
+func (*BeginTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateLLMRequest) GetName() string {
+func (x *BeginTransactionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateLLMRequest) GetConfig() *LLMConfig {
+func (x *BeginTransactionRequest) GetIsolation() IsolationLevel {
 	if x != nil {
-		return x.Config
+		return x.Isolation
 	}
-	return nil
+	return IsolationLevel_ISOLATION_LEVEL_UNSPECIFIED
 }
 
-type CreateLLMResponse struct {
+func (x *BeginTransactionRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type BeginTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Success       bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *CreateLLMResponse) Reset() {
-	*x = CreateLLMResponse{}
+func (x *BeginTransactionResponse) Reset() {
+	*x = BeginTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1929,13 +2255,13 @@ func (x *CreateLLMResponse) Reset() {
 	}
 }
 
-func (x *CreateLLMResponse) String() string {
+func (x *BeginTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLLMResponse) ProtoMessage() {}
+func (*BeginTransactionResponse) ProtoMessage() {}
 
-func (x *CreateLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *BeginTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1947,36 +2273,43 @@ func (x *CreateLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLLMResponse.ProtoReflect.Descriptor instead.
-func (*CreateLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginTransactionResponse.ProtoReflect.Descriptor instead.
+func (*BeginTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateLLMResponse) GetSuccess() bool {
+func (x *BeginTransactionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateLLMResponse) GetError() string {
+func (x *BeginTransactionResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *BeginTransactionResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type GenerateLLMRequest struct {
+type CommitTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Prompt string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *GenerateLLMRequest) Reset() {
-	*x = GenerateLLMRequest{}
+func (x *CommitTransactionRequest) Reset() {
+	*x = CommitTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1984,13 +2317,13 @@ func (x *GenerateLLMRequest) Reset() {
 	}
 }
 
-func (x *GenerateLLMRequest) String() string {
+func (x *CommitTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLLMRequest) ProtoMessage() {}
+func (*CommitTransactionRequest) ProtoMessage() {}
 
-func (x *GenerateLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *CommitTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,40 +2335,36 @@ func (x *GenerateLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLLMRequest.ProtoReflect.Descriptor instead.
-func (*GenerateLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CommitTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GenerateLLMRequest) GetName() string {
+func (x *CommitTransactionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GenerateLLMRequest) GetPrompt() string {
+func (x *CommitTransactionRequest) GetTransactionId() string {
 	if x != nil {
-		return x.Prompt
+		return x.TransactionId
 	}
 	return ""
 }
 
-type GenerateLLMResponse struct {
+type CommitTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Text         string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	TokensUsed   *uint32 `protobuf:"varint,3,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
-	FinishReason *string `protobuf:"bytes,4,opt,name=finish_reason,json=finishReason,proto3,oneof" json:"finish_reason,omitempty"`
-	Model        string  `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
-	Error        string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GenerateLLMResponse) Reset() {
-	*x = GenerateLLMResponse{}
+func (x *CommitTransactionResponse) Reset() {
+	*x = CommitTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2043,13 +2372,13 @@ func (x *GenerateLLMResponse) Reset() {
 	}
 }
 
-func (x *GenerateLLMResponse) String() string {
+func (x *CommitTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLLMResponse) ProtoMessage() {}
+func (*CommitTransactionResponse) ProtoMessage() {}
 
-func (x *GenerateLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *CommitTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2061,64 +2390,36 @@ func (x *GenerateLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLLMResponse.ProtoReflect.Descriptor instead.
-func (*GenerateLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CommitTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GenerateLLMResponse) GetSuccess() bool {
+func (x *CommitTransactionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GenerateLLMResponse) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *GenerateLLMResponse) GetTokensUsed() uint32 {
-	if x != nil && x.TokensUsed != nil {
-		return *x.TokensUsed
-	}
-	return 0
-}
-
-func (x *GenerateLLMResponse) GetFinishReason() string {
-	if x != nil && x.FinishReason != nil {
-		return *x.FinishReason
-	}
-	return ""
-}
-
-func (x *GenerateLLMResponse) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
-}
-
-func (x *GenerateLLMResponse) GetError() string {
+func (x *CommitTransactionResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type ChatLLMRequest struct {
+type RollbackTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Messages []*LLMMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *ChatLLMRequest) Reset() {
-	*x = ChatLLMRequest{}
+func (x *RollbackTransactionRequest) Reset() {
+	*x = RollbackTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2126,13 +2427,13 @@ func (x *ChatLLMRequest) Reset() {
 	}
 }
 
-func (x *ChatLLMRequest) String() string {
+func (x *RollbackTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatLLMRequest) ProtoMessage() {}
+func (*RollbackTransactionRequest) ProtoMessage() {}
 
-func (x *ChatLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2144,40 +2445,36 @@ func (x *ChatLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatLLMRequest.ProtoReflect.Descriptor instead.
-func (*ChatLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ChatLLMRequest) GetName() string {
+func (x *RollbackTransactionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ChatLLMRequest) GetMessages() []*LLMMessage {
+func (x *RollbackTransactionRequest) GetTransactionId() string {
 	if x != nil {
-		return x.Messages
+		return x.TransactionId
 	}
-	return nil
+	return ""
 }
 
-type ChatLLMResponse struct {
+type RollbackTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Text         string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	TokensUsed   *uint32 `protobuf:"varint,3,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
-	FinishReason *string `protobuf:"bytes,4,opt,name=finish_reason,json=finishReason,proto3,oneof" json:"finish_reason,omitempty"`
-	Model        string  `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
-	Error        string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ChatLLMResponse) Reset() {
-	*x = ChatLLMResponse{}
+func (x *RollbackTransactionResponse) Reset() {
+	*x = RollbackTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2185,13 +2482,13 @@ func (x *ChatLLMResponse) Reset() {
 	}
 }
 
-func (x *ChatLLMResponse) String() string {
+func (x *RollbackTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatLLMResponse) ProtoMessage() {}
+func (*RollbackTransactionResponse) ProtoMessage() {}
 
-func (x *ChatLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *RollbackTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2203,64 +2500,38 @@ func (x *ChatLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatLLMResponse.ProtoReflect.Descriptor instead.
-func (*ChatLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackTransactionResponse.ProtoReflect.Descriptor instead.
+func (*RollbackTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ChatLLMResponse) GetSuccess() bool {
+func (x *RollbackTransactionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ChatLLMResponse) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *ChatLLMResponse) GetTokensUsed() uint32 {
-	if x != nil && x.TokensUsed != nil {
-		return *x.TokensUsed
-	}
-	return 0
-}
-
-func (x *ChatLLMResponse) GetFinishReason() string {
-	if x != nil && x.FinishReason != nil {
-		return *x.FinishReason
-	}
-	return ""
-}
-
-func (x *ChatLLMResponse) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
-}
-
-func (x *ChatLLMResponse) GetError() string {
+func (x *RollbackTransactionResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type EmbeddingLLMRequest struct {
+type TableInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Schema or database holding the table; empty when the DataSource has none
+	Schema string    `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Kind   TableKind `protobuf:"varint,3,opt,name=kind,proto3,enum=operrouter.v1.TableKind" json:"kind,omitempty"`
 }
 
-func (x *EmbeddingLLMRequest) Reset() {
-	*x = EmbeddingLLMRequest{}
+func (x *TableInfo) Reset() {
+	*x = TableInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2268,13 +2539,13 @@ func (x *EmbeddingLLMRequest) Reset() {
 	}
 }
 
-func (x *EmbeddingLLMRequest) String() string {
+func (x *TableInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmbeddingLLMRequest) ProtoMessage() {}
+func (*TableInfo) ProtoMessage() {}
 
-func (x *EmbeddingLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *TableInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2286,39 +2557,44 @@ func (x *EmbeddingLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmbeddingLLMRequest.ProtoReflect.Descriptor instead.
-func (*EmbeddingLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TableInfo.ProtoReflect.Descriptor instead.
+func (*TableInfo) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *EmbeddingLLMRequest) GetName() string {
+func (x *TableInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *EmbeddingLLMRequest) GetText() string {
+func (x *TableInfo) GetSchema() string {
 	if x != nil {
-		return x.Text
+		return x.Schema
 	}
 	return ""
 }
 
-type EmbeddingLLMResponse struct {
+func (x *TableInfo) GetKind() TableKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TableKind_TABLE_KIND_UNSPECIFIED
+}
+
+type ListTablesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success    bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Embedding  []float32 `protobuf:"fixed32,2,rep,packed,name=embedding,proto3" json:"embedding,omitempty"`
-	Model      string    `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	TokensUsed *uint32   `protobuf:"varint,4,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
-	Error      string    `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Lists the default schema when empty
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *EmbeddingLLMResponse) Reset() {
-	*x = EmbeddingLLMResponse{}
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2326,13 +2602,13 @@ func (x *EmbeddingLLMResponse) Reset() {
 	}
 }
 
-func (x *EmbeddingLLMResponse) String() string {
+func (x *ListTablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmbeddingLLMResponse) ProtoMessage() {}
+func (*ListTablesRequest) ProtoMessage() {}
 
-func (x *EmbeddingLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2344,57 +2620,37 @@ func (x *EmbeddingLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmbeddingLLMResponse.ProtoReflect.Descriptor instead.
-func (*EmbeddingLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *EmbeddingLLMResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *EmbeddingLLMResponse) GetEmbedding() []float32 {
-	if x != nil {
-		return x.Embedding
-	}
-	return nil
-}
-
-func (x *EmbeddingLLMResponse) GetModel() string {
+func (x *ListTablesRequest) GetName() string {
 	if x != nil {
-		return x.Model
+		return x.Name
 	}
 	return ""
 }
 
-func (x *EmbeddingLLMResponse) GetTokensUsed() uint32 {
-	if x != nil && x.TokensUsed != nil {
-		return *x.TokensUsed
-	}
-	return 0
-}
-
-func (x *EmbeddingLLMResponse) GetError() string {
+func (x *ListTablesRequest) GetSchema() string {
 	if x != nil {
-		return x.Error
+		return x.Schema
 	}
 	return ""
 }
 
-type StreamLLMRequest struct {
+type ListTablesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Prompt string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Success bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Tables  []*TableInfo `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	Error   string       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *StreamLLMRequest) Reset() {
-	*x = StreamLLMRequest{}
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2402,13 +2658,13 @@ func (x *StreamLLMRequest) Reset() {
 	}
 }
 
-func (x *StreamLLMRequest) String() string {
+func (x *ListTablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamLLMRequest) ProtoMessage() {}
+func (*ListTablesResponse) ProtoMessage() {}
 
-func (x *StreamLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2420,38 +2676,49 @@ func (x *StreamLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamLLMRequest.ProtoReflect.Descriptor instead.
-func (*StreamLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *StreamLLMRequest) GetName() string {
+func (x *ListTablesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Name
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *StreamLLMRequest) GetPrompt() string {
+func (x *ListTablesResponse) GetTables() []*TableInfo {
 	if x != nil {
-		return x.Prompt
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ListTablesResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type StreamLLMResponse struct {
+type ColumnInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Chunk   string `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
-	Done    bool   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
-	Error   string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type as the database reports it, e.g. "varchar(255)"; for document
+	// DataSources, the Value variant seen in sampled documents
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Nullable   bool   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	PrimaryKey bool   `protobuf:"varint,4,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// Default expression, when the column has one
+	DefaultValue *string `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
 }
 
-func (x *StreamLLMResponse) Reset() {
-	*x = StreamLLMResponse{}
+func (x *ColumnInfo) Reset() {
+	*x = ColumnInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2459,13 +2726,13 @@ func (x *StreamLLMResponse) Reset() {
 	}
 }
 
-func (x *StreamLLMResponse) String() string {
+func (x *ColumnInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamLLMResponse) ProtoMessage() {}
+func (*ColumnInfo) ProtoMessage() {}
 
-func (x *StreamLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *ColumnInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2477,49 +2744,59 @@ func (x *StreamLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamLLMResponse.ProtoReflect.Descriptor instead.
-func (*StreamLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColumnInfo.ProtoReflect.Descriptor instead.
+func (*ColumnInfo) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StreamLLMResponse) GetSuccess() bool {
+func (x *ColumnInfo) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *StreamLLMResponse) GetChunk() string {
+func (x *ColumnInfo) GetType() string {
 	if x != nil {
-		return x.Chunk
+		return x.Type
 	}
 	return ""
 }
 
-func (x *StreamLLMResponse) GetDone() bool {
+func (x *ColumnInfo) GetNullable() bool {
 	if x != nil {
-		return x.Done
+		return x.Nullable
 	}
 	return false
 }
 
-func (x *StreamLLMResponse) GetError() string {
+func (x *ColumnInfo) GetPrimaryKey() bool {
 	if x != nil {
-		return x.Error
+		return x.PrimaryKey
+	}
+	return false
+}
+
+func (x *ColumnInfo) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
 	}
 	return ""
 }
 
-type PingLLMRequest struct {
+type DescribeTableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Uses the default schema when empty
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
 }
 
-func (x *PingLLMRequest) Reset() {
-	*x = PingLLMRequest{}
+func (x *DescribeTableRequest) Reset() {
+	*x = DescribeTableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2527,13 +2804,13 @@ func (x *PingLLMRequest) Reset() {
 	}
 }
 
-func (x *PingLLMRequest) String() string {
+func (x *DescribeTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingLLMRequest) ProtoMessage() {}
+func (*DescribeTableRequest) ProtoMessage() {}
 
-func (x *PingLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeTableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2545,29 +2822,46 @@ func (x *PingLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingLLMRequest.ProtoReflect.Descriptor instead.
-func (*PingLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeTableRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTableRequest) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *PingLLMRequest) GetName() string {
+func (x *DescribeTableRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type PingLLMResponse struct {
+func (x *DescribeTableRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *DescribeTableRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+type DescribeTableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Healthy bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Success bool       `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Table   *TableInfo `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// Columns in table order
+	Columns []*ColumnInfo `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Error   string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *PingLLMResponse) Reset() {
-	*x = PingLLMResponse{}
+func (x *DescribeTableResponse) Reset() {
+	*x = DescribeTableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2575,13 +2869,13 @@ func (x *PingLLMResponse) Reset() {
 	}
 }
 
-func (x *PingLLMResponse) String() string {
+func (x *DescribeTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingLLMResponse) ProtoMessage() {}
+func (*DescribeTableResponse) ProtoMessage() {}
 
-func (x *PingLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeTableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2593,35 +2887,51 @@ func (x *PingLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingLLMResponse.ProtoReflect.Descriptor instead.
-func (*PingLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeTableResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTableResponse) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *PingLLMResponse) GetHealthy() bool {
+func (x *DescribeTableResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Healthy
+		return x.Success
 	}
 	return false
 }
 
-func (x *PingLLMResponse) GetError() string {
+func (x *DescribeTableResponse) GetTable() *TableInfo {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *DescribeTableResponse) GetColumns() []*ColumnInfo {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DescribeTableResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CloseLLMRequest struct {
+// LLM operations
+type KafkaHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *CloseLLMRequest) Reset() {
-	*x = CloseLLMRequest{}
+func (x *KafkaHeader) Reset() {
+	*x = KafkaHeader{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2629,13 +2939,13 @@ func (x *CloseLLMRequest) Reset() {
 	}
 }
 
-func (x *CloseLLMRequest) String() string {
+func (x *KafkaHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseLLMRequest) ProtoMessage() {}
+func (*KafkaHeader) ProtoMessage() {}
 
-func (x *CloseLLMRequest) ProtoReflect() protoreflect.Message {
+func (x *KafkaHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2647,29 +2957,42 @@ func (x *CloseLLMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseLLMRequest.ProtoReflect.Descriptor instead.
-func (*CloseLLMRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KafkaHeader.ProtoReflect.Descriptor instead.
+func (*KafkaHeader) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CloseLLMRequest) GetName() string {
+func (x *KafkaHeader) GetKey() string {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
 	return ""
 }
 
-type CloseLLMResponse struct {
+func (x *KafkaHeader) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type KafkaRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Topic   string         `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Key     []byte         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []byte         `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Headers []*KafkaHeader `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Chosen by the partitioner when unset
+	Partition *int32 `protobuf:"varint,5,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
+	// Set by the producer when unset
+	TimestampMs *int64 `protobuf:"varint,6,opt,name=timestamp_ms,json=timestampMs,proto3,oneof" json:"timestamp_ms,omitempty"`
 }
 
-func (x *CloseLLMResponse) Reset() {
-	*x = CloseLLMResponse{}
+func (x *KafkaRecord) Reset() {
+	*x = KafkaRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_operrouter_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2677,13 +3000,13 @@ func (x *CloseLLMResponse) Reset() {
 	}
 }
 
-func (x *CloseLLMResponse) String() string {
+func (x *KafkaRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseLLMResponse) ProtoMessage() {}
+func (*KafkaRecord) ProtoMessage() {}
 
-func (x *CloseLLMResponse) ProtoReflect() protoreflect.Message {
+func (x *KafkaRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_operrouter_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2695,578 +3018,4625 @@ func (x *CloseLLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseLLMResponse.ProtoReflect.Descriptor instead.
-func (*CloseLLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KafkaRecord.ProtoReflect.Descriptor instead.
+func (*KafkaRecord) Descriptor() ([]byte, []int) {
 	return file_proto_operrouter_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CloseLLMResponse) GetSuccess() bool {
+func (x *KafkaRecord) GetTopic() string {
 	if x != nil {
-		return x.Success
+		return x.Topic
 	}
-	return false
+	return ""
 }
 
-func (x *CloseLLMResponse) GetError() string {
+func (x *KafkaRecord) GetKey() []byte {
 	if x != nil {
-		return x.Error
+		return x.Key
 	}
-	return ""
+	return nil
 }
 
-var File_proto_operrouter_proto protoreflect.FileDescriptor
-
-var file_proto_operrouter_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x5a, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x40, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x6f, 0x6d, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6d, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x46, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x69,
-	0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd7, 0x02, 0x0a, 0x10,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
-	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
-	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0e,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x40, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xdb, 0x02, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1f, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
-	0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x61,
-	0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x61,
-	0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
-	0x9e, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x3e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a,
-	0x4f, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x92, 0x01, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x2e, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x1a, 0x50, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4a, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x16, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x71, 0x0a,
-	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x44, 0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x70, 0x0a, 0x19, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f,
-	0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70,
-	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69,
-	0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x15, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a,
-	0x16, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x09, 0x4c, 0x4c, 0x4d, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x07,
-	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x61, 0x70,
-	0x69, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07,
-	0x61, 0x70, 0x69, 0x42, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x12, 0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x6d,
-	0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x70,
-	0x69, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x56, 0x0a, 0x0a, 0x4c, 0x4c, 0x4d, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x12,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22, 0xe1,
-	0x01, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75,
-	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x66, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0xdd, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x42, 0x10, 0x0a,
-	0x0e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x3d, 0x0a, 0x13, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb0,
-	0x01, 0x0a, 0x14, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x02, 0x52, 0x09, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f,
-	0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65,
-	0x64, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70,
-	0x74, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x24, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c,
-	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x42, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x2a, 0xc5, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x59,
-	0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52,
-	0x45, 0x53, 0x51, 0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x53,
-	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x10, 0x04, 0x12, 0x1c,
-	0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x47, 0x4f, 0x44, 0x42, 0x10, 0x05, 0x2a, 0x91, 0x01, 0x0a,
-	0x0b, 0x4c, 0x4c, 0x4d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x18,
-	0x4c, 0x4c, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4c,
-	0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x41,
-	0x49, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4c, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49,
-	0x44, 0x45, 0x52, 0x5f, 0x4f, 0x4c, 0x4c, 0x41, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
-	0x4c, 0x4c, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x54,
-	0x48, 0x52, 0x4f, 0x50, 0x49, 0x43, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4c, 0x4d, 0x5f,
-	0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x04,
-	0x2a, 0x77, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x59,
-	0x53, 0x54, 0x45, 0x4d, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
-	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a,
-	0x16, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x53,
-	0x53, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x03, 0x32, 0xdc, 0x0b, 0x0a, 0x0a, 0x4f, 0x70,
-	0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67,
-	0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f,
-	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x6f, 0x70,
-	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x63, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x2e,
-	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x63, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f,
-	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4c, 0x4c, 0x4d, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x4c,
-	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07,
-	0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
-	0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
-	0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64,
-	0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x50, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x12, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
-	0x01, 0x12, 0x48, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x12, 0x1d, 0x2e, 0x6f,
-	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
-	0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x70,
-	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x4c, 0x4d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x4c, 0x4d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa8, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0f,
-	0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70,
-	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x6f, 0x70, 0x65, 0x72,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *KafkaRecord) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
 }
 
-var (
-	file_proto_operrouter_proto_rawDescOnce sync.Once
-	file_proto_operrouter_proto_rawDescData = file_proto_operrouter_proto_rawDesc
-)
+func (x *KafkaRecord) GetHeaders() []*KafkaHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
 
-func file_proto_operrouter_proto_rawDescGZIP() []byte {
-	file_proto_operrouter_proto_rawDescOnce.Do(func() {
-		file_proto_operrouter_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_operrouter_proto_rawDescData)
-	})
-	return file_proto_operrouter_proto_rawDescData
+func (x *KafkaRecord) GetPartition() int32 {
+	if x != nil && x.Partition != nil {
+		return *x.Partition
+	}
+	return 0
 }
 
-var file_proto_operrouter_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_operrouter_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-var file_proto_operrouter_proto_goTypes = []any{
-	(DataSourceType)(0),               // 0: operrouter.v1.DataSourceType
-	(LLMProvider)(0),                  // 1: operrouter.v1.LLMProvider
-	(MessageRole)(0),                  // 2: operrouter.v1.MessageRole
-	(*Metadata)(nil),                  // 3: operrouter.v1.Metadata
-	(*PingRequest)(nil),               // 4: operrouter.v1.PingRequest
-	(*PingResponse)(nil),              // 5: operrouter.v1.PingResponse
-	(*ValidateConfigRequest)(nil),     // 6: operrouter.v1.ValidateConfigRequest
-	(*ValidateConfigResponse)(nil),    // 7: operrouter.v1.ValidateConfigResponse
-	(*LoadConfigRequest)(nil),         // 8: operrouter.v1.LoadConfigRequest
-	(*LoadConfigResponse)(nil),        // 9: operrouter.v1.LoadConfigResponse
-	(*GetMetadataRequest)(nil),        // 10: operrouter.v1.GetMetadataRequest
-	(*GetMetadataResponse)(nil),       // 11: operrouter.v1.GetMetadataResponse
-	(*DataSourceConfig)(nil),          // 12: operrouter.v1.DataSourceConfig
-	(*Value)(nil),                     // 13: operrouter.v1.Value
-	(*ValueArray)(nil),                // 14: operrouter.v1.ValueArray
-	(*ValueObject)(nil),               // 15: operrouter.v1.ValueObject
-	(*Row)(nil),                       // 16: operrouter.v1.Row
-	(*CreateDataSourceRequest)(nil),   // 17: operrouter.v1.CreateDataSourceRequest
-	(*CreateDataSourceResponse)(nil),  // 18: operrouter.v1.CreateDataSourceResponse
-	(*QueryDataSourceRequest)(nil),    // 19: operrouter.v1.QueryDataSourceRequest
-	(*QueryDataSourceResponse)(nil),   // 20: operrouter.v1.QueryDataSourceResponse
-	(*ExecuteDataSourceRequest)(nil),  // 21: operrouter.v1.ExecuteDataSourceRequest
-	(*ExecuteDataSourceResponse)(nil), // 22: operrouter.v1.ExecuteDataSourceResponse
-	(*InsertDataSourceRequest)(nil),   // 23: operrouter.v1.InsertDataSourceRequest
-	(*InsertDataSourceResponse)(nil),  // 24: operrouter.v1.InsertDataSourceResponse
-	(*PingDataSourceRequest)(nil),     // 25: operrouter.v1.PingDataSourceRequest
-	(*PingDataSourceResponse)(nil),    // 26: operrouter.v1.PingDataSourceResponse
-	(*CloseDataSourceRequest)(nil),    // 27: operrouter.v1.CloseDataSourceRequest
-	(*CloseDataSourceResponse)(nil),   // 28: operrouter.v1.CloseDataSourceResponse
-	(*LLMConfig)(nil),                 // 29: operrouter.v1.LLMConfig
-	(*LLMMessage)(nil),                // 30: operrouter.v1.LLMMessage
-	(*CreateLLMRequest)(nil),          // 31: operrouter.v1.CreateLLMRequest
-	(*CreateLLMResponse)(nil),         // 32: operrouter.v1.CreateLLMResponse
-	(*GenerateLLMRequest)(nil),        // 33: operrouter.v1.GenerateLLMRequest
-	(*GenerateLLMResponse)(nil),       // 34: operrouter.v1.GenerateLLMResponse
-	(*ChatLLMRequest)(nil),            // 35: operrouter.v1.ChatLLMRequest
-	(*ChatLLMResponse)(nil),           // 36: operrouter.v1.ChatLLMResponse
-	(*EmbeddingLLMRequest)(nil),       // 37: operrouter.v1.EmbeddingLLMRequest
-	(*EmbeddingLLMResponse)(nil),      // 38: operrouter.v1.EmbeddingLLMResponse
-	(*StreamLLMRequest)(nil),          // 39: operrouter.v1.StreamLLMRequest
-	(*StreamLLMResponse)(nil),         // 40: operrouter.v1.StreamLLMResponse
-	(*PingLLMRequest)(nil),            // 41: operrouter.v1.PingLLMRequest
-	(*PingLLMResponse)(nil),           // 42: operrouter.v1.PingLLMResponse
-	(*CloseLLMRequest)(nil),           // 43: operrouter.v1.CloseLLMRequest
-	(*CloseLLMResponse)(nil),          // 44: operrouter.v1.CloseLLMResponse
-	nil,                               // 45: operrouter.v1.DataSourceConfig.ExtraEntry
-	nil,                               // 46: operrouter.v1.ValueObject.FieldsEntry
-	nil,                               // 47: operrouter.v1.Row.ColumnsEntry
+func (x *KafkaRecord) GetTimestampMs() int64 {
+	if x != nil && x.TimestampMs != nil {
+		return *x.TimestampMs
+	}
+	return 0
 }
-var file_proto_operrouter_proto_depIdxs = []int32{
-	3,  // 0: operrouter.v1.GetMetadataResponse.metadata:type_name -> operrouter.v1.Metadata
-	0,  // 1: operrouter.v1.DataSourceConfig.type:type_name -> operrouter.v1.DataSourceType
-	45, // 2: operrouter.v1.DataSourceConfig.extra:type_name -> operrouter.v1.DataSourceConfig.ExtraEntry
-	14, // 3: operrouter.v1.Value.array_value:type_name -> operrouter.v1.ValueArray
-	15, // 4: operrouter.v1.Value.object_value:type_name -> operrouter.v1.ValueObject
-	13, // 5: operrouter.v1.ValueArray.values:type_name -> operrouter.v1.Value
-	46, // 6: operrouter.v1.ValueObject.fields:type_name -> operrouter.v1.ValueObject.FieldsEntry
-	47, // 7: operrouter.v1.Row.columns:type_name -> operrouter.v1.Row.ColumnsEntry
-	12, // 8: operrouter.v1.CreateDataSourceRequest.config:type_name -> operrouter.v1.DataSourceConfig
-	16, // 9: operrouter.v1.QueryDataSourceResponse.rows:type_name -> operrouter.v1.Row
-	16, // 10: operrouter.v1.InsertDataSourceRequest.data:type_name -> operrouter.v1.Row
-	1,  // 11: operrouter.v1.LLMConfig.provider:type_name -> operrouter.v1.LLMProvider
-	2,  // 12: operrouter.v1.LLMMessage.role:type_name -> operrouter.v1.MessageRole
-	29, // 13: operrouter.v1.CreateLLMRequest.config:type_name -> operrouter.v1.LLMConfig
-	30, // 14: operrouter.v1.ChatLLMRequest.messages:type_name -> operrouter.v1.LLMMessage
-	13, // 15: operrouter.v1.ValueObject.FieldsEntry.value:type_name -> operrouter.v1.Value
-	13, // 16: operrouter.v1.Row.ColumnsEntry.value:type_name -> operrouter.v1.Value
-	4,  // 17: operrouter.v1.OperRouter.Ping:input_type -> operrouter.v1.PingRequest
-	6,  // 18: operrouter.v1.OperRouter.ValidateConfig:input_type -> operrouter.v1.ValidateConfigRequest
-	8,  // 19: operrouter.v1.OperRouter.LoadConfig:input_type -> operrouter.v1.LoadConfigRequest
-	10, // 20: operrouter.v1.OperRouter.GetMetadata:input_type -> operrouter.v1.GetMetadataRequest
-	17, // 21: operrouter.v1.OperRouter.CreateDataSource:input_type -> operrouter.v1.CreateDataSourceRequest
-	19, // 22: operrouter.v1.OperRouter.QueryDataSource:input_type -> operrouter.v1.QueryDataSourceRequest
-	21, // 23: operrouter.v1.OperRouter.ExecuteDataSource:input_type -> operrouter.v1.ExecuteDataSourceRequest
-	23, // 24: operrouter.v1.OperRouter.InsertDataSource:input_type -> operrouter.v1.InsertDataSourceRequest
-	25, // 25: operrouter.v1.OperRouter.PingDataSource:input_type -> operrouter.v1.PingDataSourceRequest
-	27, // 26: operrouter.v1.OperRouter.CloseDataSource:input_type -> operrouter.v1.CloseDataSourceRequest
-	31, // 27: operrouter.v1.OperRouter.CreateLLM:input_type -> operrouter.v1.CreateLLMRequest
-	33, // 28: operrouter.v1.OperRouter.GenerateLLM:input_type -> operrouter.v1.GenerateLLMRequest
-	35, // 29: operrouter.v1.OperRouter.ChatLLM:input_type -> operrouter.v1.ChatLLMRequest
-	37, // 30: operrouter.v1.OperRouter.EmbeddingLLM:input_type -> operrouter.v1.EmbeddingLLMRequest
-	39, // 31: operrouter.v1.OperRouter.StreamLLM:input_type -> operrouter.v1.StreamLLMRequest
-	41, // 32: operrouter.v1.OperRouter.PingLLM:input_type -> operrouter.v1.PingLLMRequest
-	43, // 33: operrouter.v1.OperRouter.CloseLLM:input_type -> operrouter.v1.CloseLLMRequest
-	5,  // 34: operrouter.v1.OperRouter.Ping:output_type -> operrouter.v1.PingResponse
-	7,  // 35: operrouter.v1.OperRouter.ValidateConfig:output_type -> operrouter.v1.ValidateConfigResponse
-	9,  // 36: operrouter.v1.OperRouter.LoadConfig:output_type -> operrouter.v1.LoadConfigResponse
-	11, // 37: operrouter.v1.OperRouter.GetMetadata:output_type -> operrouter.v1.GetMetadataResponse
-	18, // 38: operrouter.v1.OperRouter.CreateDataSource:output_type -> operrouter.v1.CreateDataSourceResponse
-	20, // 39: operrouter.v1.OperRouter.QueryDataSource:output_type -> operrouter.v1.QueryDataSourceResponse
-	22, // 40: operrouter.v1.OperRouter.ExecuteDataSource:output_type -> operrouter.v1.ExecuteDataSourceResponse
-	24, // 41: operrouter.v1.OperRouter.InsertDataSource:output_type -> operrouter.v1.InsertDataSourceResponse
-	26, // 42: operrouter.v1.OperRouter.PingDataSource:output_type -> operrouter.v1.PingDataSourceResponse
-	28, // 43: operrouter.v1.OperRouter.CloseDataSource:output_type -> operrouter.v1.CloseDataSourceResponse
-	32, // 44: operrouter.v1.OperRouter.CreateLLM:output_type -> operrouter.v1.CreateLLMResponse
-	34, // 45: operrouter.v1.OperRouter.GenerateLLM:output_type -> operrouter.v1.GenerateLLMResponse
-	36, // 46: operrouter.v1.OperRouter.ChatLLM:output_type -> operrouter.v1.ChatLLMResponse
-	38, // 47: operrouter.v1.OperRouter.EmbeddingLLM:output_type -> operrouter.v1.EmbeddingLLMResponse
-	40, // 48: operrouter.v1.OperRouter.StreamLLM:output_type -> operrouter.v1.StreamLLMResponse
-	42, // 49: operrouter.v1.OperRouter.PingLLM:output_type -> operrouter.v1.PingLLMResponse
-	44, // 50: operrouter.v1.OperRouter.CloseLLM:output_type -> operrouter.v1.CloseLLMResponse
-	34, // [34:51] is the sub-list for method output_type
-	17, // [17:34] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+type KafkaPublishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Records []*KafkaRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	Acks    KafkaAcks      `protobuf:"varint,3,opt,name=acks,proto3,enum=operrouter.v1.KafkaAcks" json:"acks,omitempty"`
 }
 
-func init() { file_proto_operrouter_proto_init() }
-func file_proto_operrouter_proto_init() {
-	if File_proto_operrouter_proto != nil {
-		return
+func (x *KafkaPublishRequest) Reset() {
+	*x = KafkaPublishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_operrouter_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Metadata); i {
+}
+
+func (x *KafkaPublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaPublishRequest) ProtoMessage() {}
+
+func (x *KafkaPublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaPublishRequest.ProtoReflect.Descriptor instead.
+func (*KafkaPublishRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *KafkaPublishRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KafkaPublishRequest) GetRecords() []*KafkaRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *KafkaPublishRequest) GetAcks() KafkaAcks {
+	if x != nil {
+		return x.Acks
+	}
+	return KafkaAcks_KAFKA_ACKS_UNSPECIFIED
+}
+
+// Delivery report for one record, in request order
+type KafkaDeliveryReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partition int32  `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Error     string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *KafkaDeliveryReport) Reset() {
+	*x = KafkaDeliveryReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaDeliveryReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaDeliveryReport) ProtoMessage() {}
+
+func (x *KafkaDeliveryReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaDeliveryReport.ProtoReflect.Descriptor instead.
+func (*KafkaDeliveryReport) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *KafkaDeliveryReport) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *KafkaDeliveryReport) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *KafkaDeliveryReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type KafkaPublishResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reports []*KafkaDeliveryReport `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	Error   string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *KafkaPublishResponse) Reset() {
+	*x = KafkaPublishResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaPublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaPublishResponse) ProtoMessage() {}
+
+func (x *KafkaPublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaPublishResponse.ProtoReflect.Descriptor instead.
+func (*KafkaPublishResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *KafkaPublishResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *KafkaPublishResponse) GetReports() []*KafkaDeliveryReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *KafkaPublishResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type KafkaSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Topics  []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	GroupId string   `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Start from the earliest offset when the group has no committed offset
+	FromEarliest bool `protobuf:"varint,4,opt,name=from_earliest,json=fromEarliest,proto3" json:"from_earliest,omitempty"`
+	// Let the consumer commit offsets periodically
+	AutoCommit bool `protobuf:"varint,5,opt,name=auto_commit,json=autoCommit,proto3" json:"auto_commit,omitempty"`
+}
+
+func (x *KafkaSubscribeRequest) Reset() {
+	*x = KafkaSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaSubscribeRequest) ProtoMessage() {}
+
+func (x *KafkaSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*KafkaSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *KafkaSubscribeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KafkaSubscribeRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *KafkaSubscribeRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *KafkaSubscribeRequest) GetFromEarliest() bool {
+	if x != nil {
+		return x.FromEarliest
+	}
+	return false
+}
+
+func (x *KafkaSubscribeRequest) GetAutoCommit() bool {
+	if x != nil {
+		return x.AutoCommit
+	}
+	return false
+}
+
+type KafkaMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic       string         `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition   int32          `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset      int64          `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key         []byte         `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Value       []byte         `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Headers     []*KafkaHeader `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	TimestampMs int64          `protobuf:"varint,7,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (x *KafkaMessage) Reset() {
+	*x = KafkaMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaMessage) ProtoMessage() {}
+
+func (x *KafkaMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaMessage.ProtoReflect.Descriptor instead.
+func (*KafkaMessage) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *KafkaMessage) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KafkaMessage) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *KafkaMessage) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *KafkaMessage) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KafkaMessage) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KafkaMessage) GetHeaders() []*KafkaHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *KafkaMessage) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+type KafkaSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Messages []*KafkaMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Error    string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *KafkaSubscribeResponse) Reset() {
+	*x = KafkaSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaSubscribeResponse) ProtoMessage() {}
+
+func (x *KafkaSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*KafkaSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *KafkaSubscribeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *KafkaSubscribeResponse) GetMessages() []*KafkaMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *KafkaSubscribeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type KafkaPartitionOffset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic     string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition int32  `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Offset of the next message to consume
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *KafkaPartitionOffset) Reset() {
+	*x = KafkaPartitionOffset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaPartitionOffset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaPartitionOffset) ProtoMessage() {}
+
+func (x *KafkaPartitionOffset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaPartitionOffset.ProtoReflect.Descriptor instead.
+func (*KafkaPartitionOffset) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *KafkaPartitionOffset) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KafkaPartitionOffset) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *KafkaPartitionOffset) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type KafkaCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GroupId string                  `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Offsets []*KafkaPartitionOffset `protobuf:"bytes,3,rep,name=offsets,proto3" json:"offsets,omitempty"`
+}
+
+func (x *KafkaCommitRequest) Reset() {
+	*x = KafkaCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaCommitRequest) ProtoMessage() {}
+
+func (x *KafkaCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaCommitRequest.ProtoReflect.Descriptor instead.
+func (*KafkaCommitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *KafkaCommitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KafkaCommitRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *KafkaCommitRequest) GetOffsets() []*KafkaPartitionOffset {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+type KafkaCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *KafkaCommitResponse) Reset() {
+	*x = KafkaCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaCommitResponse) ProtoMessage() {}
+
+func (x *KafkaCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaCommitResponse.ProtoReflect.Descriptor instead.
+func (*KafkaCommitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *KafkaCommitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *KafkaCommitResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SortField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field      string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Descending bool   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SortField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortField) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type MongoFindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Collection string       `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Filter     *ValueObject `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Projection *ValueObject `protobuf:"bytes,4,opt,name=projection,proto3" json:"projection,omitempty"`
+	Sort       []*SortField `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	Limit      *int64       `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Skip       *int64       `protobuf:"varint,7,opt,name=skip,proto3,oneof" json:"skip,omitempty"`
+}
+
+func (x *MongoFindRequest) Reset() {
+	*x = MongoFindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoFindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoFindRequest) ProtoMessage() {}
+
+func (x *MongoFindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoFindRequest.ProtoReflect.Descriptor instead.
+func (*MongoFindRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MongoFindRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MongoFindRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *MongoFindRequest) GetFilter() *ValueObject {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MongoFindRequest) GetProjection() *ValueObject {
+	if x != nil {
+		return x.Projection
+	}
+	return nil
+}
+
+func (x *MongoFindRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *MongoFindRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *MongoFindRequest) GetSkip() int64 {
+	if x != nil && x.Skip != nil {
+		return *x.Skip
+	}
+	return 0
+}
+
+type MongoFindResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Documents []*ValueObject `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
+	Error     string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MongoFindResponse) Reset() {
+	*x = MongoFindResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoFindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoFindResponse) ProtoMessage() {}
+
+func (x *MongoFindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoFindResponse.ProtoReflect.Descriptor instead.
+func (*MongoFindResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MongoFindResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MongoFindResponse) GetDocuments() []*ValueObject {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *MongoFindResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MongoInsertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Collection string         `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Documents  []*ValueObject `protobuf:"bytes,3,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *MongoInsertRequest) Reset() {
+	*x = MongoInsertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoInsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoInsertRequest) ProtoMessage() {}
+
+func (x *MongoInsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoInsertRequest.ProtoReflect.Descriptor instead.
+func (*MongoInsertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MongoInsertRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MongoInsertRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *MongoInsertRequest) GetDocuments() []*ValueObject {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type MongoInsertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// _id of each inserted document, in order
+	InsertedIds []*Value `protobuf:"bytes,2,rep,name=inserted_ids,json=insertedIds,proto3" json:"inserted_ids,omitempty"`
+	Error       string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MongoInsertResponse) Reset() {
+	*x = MongoInsertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoInsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoInsertResponse) ProtoMessage() {}
+
+func (x *MongoInsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoInsertResponse.ProtoReflect.Descriptor instead.
+func (*MongoInsertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MongoInsertResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MongoInsertResponse) GetInsertedIds() []*Value {
+	if x != nil {
+		return x.InsertedIds
+	}
+	return nil
+}
+
+func (x *MongoInsertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MongoUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Collection string       `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Filter     *ValueObject `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Update document, e.g. {"$set": {...}}
+	Update *ValueObject `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
+	// Update every match instead of the first
+	Many   bool `protobuf:"varint,5,opt,name=many,proto3" json:"many,omitempty"`
+	Upsert bool `protobuf:"varint,6,opt,name=upsert,proto3" json:"upsert,omitempty"`
+}
+
+func (x *MongoUpdateRequest) Reset() {
+	*x = MongoUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoUpdateRequest) ProtoMessage() {}
+
+func (x *MongoUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoUpdateRequest.ProtoReflect.Descriptor instead.
+func (*MongoUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MongoUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MongoUpdateRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *MongoUpdateRequest) GetFilter() *ValueObject {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MongoUpdateRequest) GetUpdate() *ValueObject {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *MongoUpdateRequest) GetMany() bool {
+	if x != nil {
+		return x.Many
+	}
+	return false
+}
+
+func (x *MongoUpdateRequest) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+type MongoUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success       bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	MatchedCount  uint64 `protobuf:"varint,2,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	ModifiedCount uint64 `protobuf:"varint,3,opt,name=modified_count,json=modifiedCount,proto3" json:"modified_count,omitempty"`
+	UpsertedId    *Value `protobuf:"bytes,4,opt,name=upserted_id,json=upsertedId,proto3,oneof" json:"upserted_id,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MongoUpdateResponse) Reset() {
+	*x = MongoUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoUpdateResponse) ProtoMessage() {}
+
+func (x *MongoUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoUpdateResponse.ProtoReflect.Descriptor instead.
+func (*MongoUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MongoUpdateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MongoUpdateResponse) GetMatchedCount() uint64 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
+}
+
+func (x *MongoUpdateResponse) GetModifiedCount() uint64 {
+	if x != nil {
+		return x.ModifiedCount
+	}
+	return 0
+}
+
+func (x *MongoUpdateResponse) GetUpsertedId() *Value {
+	if x != nil {
+		return x.UpsertedId
+	}
+	return nil
+}
+
+func (x *MongoUpdateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MongoDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Collection string       `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Filter     *ValueObject `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Delete every match instead of the first
+	Many bool `protobuf:"varint,4,opt,name=many,proto3" json:"many,omitempty"`
+}
+
+func (x *MongoDeleteRequest) Reset() {
+	*x = MongoDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoDeleteRequest) ProtoMessage() {}
+
+func (x *MongoDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoDeleteRequest.ProtoReflect.Descriptor instead.
+func (*MongoDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MongoDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MongoDeleteRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *MongoDeleteRequest) GetFilter() *ValueObject {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MongoDeleteRequest) GetMany() bool {
+	if x != nil {
+		return x.Many
+	}
+	return false
+}
+
+type MongoDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	DeletedCount uint64 `protobuf:"varint,2,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MongoDeleteResponse) Reset() {
+	*x = MongoDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MongoDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MongoDeleteResponse) ProtoMessage() {}
+
+func (x *MongoDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MongoDeleteResponse.ProtoReflect.Descriptor instead.
+func (*MongoDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MongoDeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MongoDeleteResponse) GetDeletedCount() uint64 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *MongoDeleteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LLMConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       LLMProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=operrouter.v1.LLMProvider" json:"provider,omitempty"`
+	ApiKey         *string     `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,oneof" json:"api_key,omitempty"`
+	ApiBase        *string     `protobuf:"bytes,3,opt,name=api_base,json=apiBase,proto3,oneof" json:"api_base,omitempty"`
+	Model          string      `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Temperature    *float32    `protobuf:"fixed32,5,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	MaxTokens      *uint32     `protobuf:"varint,6,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
+	TimeoutSeconds *uint64     `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	// Provider-specific settings the SDK does not model
+	Extra map[string]string `protobuf:"bytes,8,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *LLMConfig) Reset() {
+	*x = LLMConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLMConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMConfig) ProtoMessage() {}
+
+func (x *LLMConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMConfig.ProtoReflect.Descriptor instead.
+func (*LLMConfig) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LLMConfig) GetProvider() LLMProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return LLMProvider_LLM_PROVIDER_UNSPECIFIED
+}
+
+func (x *LLMConfig) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+func (x *LLMConfig) GetApiBase() string {
+	if x != nil && x.ApiBase != nil {
+		return *x.ApiBase
+	}
+	return ""
+}
+
+func (x *LLMConfig) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *LLMConfig) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *LLMConfig) GetMaxTokens() uint32 {
+	if x != nil && x.MaxTokens != nil {
+		return *x.MaxTokens
+	}
+	return 0
+}
+
+func (x *LLMConfig) GetTimeoutSeconds() uint64 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *LLMConfig) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type ImagePart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either url or data is set
+	Url  string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// MIME type of data, e.g. "image/png"
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+}
+
+func (x *ImagePart) Reset() {
+	*x = ImagePart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePart) ProtoMessage() {}
+
+func (x *ImagePart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePart.ProtoReflect.Descriptor instead.
+func (*ImagePart) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ImagePart) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImagePart) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImagePart) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type ContentPart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Part:
+	//
+	//	*ContentPart_Text
+	//	*ContentPart_Image
+	Part isContentPart_Part `protobuf_oneof:"part"`
+}
+
+func (x *ContentPart) Reset() {
+	*x = ContentPart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentPart) ProtoMessage() {}
+
+func (x *ContentPart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentPart.ProtoReflect.Descriptor instead.
+func (*ContentPart) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{62}
+}
+
+func (m *ContentPart) GetPart() isContentPart_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (x *ContentPart) GetText() string {
+	if x, ok := x.GetPart().(*ContentPart_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ContentPart) GetImage() *ImagePart {
+	if x, ok := x.GetPart().(*ContentPart_Image); ok {
+		return x.Image
+	}
+	return nil
+}
+
+type isContentPart_Part interface {
+	isContentPart_Part()
+}
+
+type ContentPart_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type ContentPart_Image struct {
+	Image *ImagePart `protobuf:"bytes,2,opt,name=image,proto3,oneof"`
+}
+
+func (*ContentPart_Text) isContentPart_Part() {}
+
+func (*ContentPart_Image) isContentPart_Part() {}
+
+type LLMMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role MessageRole `protobuf:"varint,1,opt,name=role,proto3,enum=operrouter.v1.MessageRole" json:"role,omitempty"`
+	// Plain text content; ignored when parts is set
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Participant name, or the tool name for tool messages
+	Name *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Multimodal content, in order
+	Parts []*ContentPart `protobuf:"bytes,4,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Calls requested by an assistant message
+	ToolCalls []*ToolCall `protobuf:"bytes,5,rep,name=tool_calls,json=toolCalls,proto3" json:"tool_calls,omitempty"`
+	// Call answered by a tool message
+	ToolCallId *string `protobuf:"bytes,6,opt,name=tool_call_id,json=toolCallId,proto3,oneof" json:"tool_call_id,omitempty"`
+}
+
+func (x *LLMMessage) Reset() {
+	*x = LLMMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLMMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMMessage) ProtoMessage() {}
+
+func (x *LLMMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMMessage.ProtoReflect.Descriptor instead.
+func (*LLMMessage) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LLMMessage) GetRole() MessageRole {
+	if x != nil {
+		return x.Role
+	}
+	return MessageRole_MESSAGE_ROLE_UNSPECIFIED
+}
+
+func (x *LLMMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LLMMessage) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *LLMMessage) GetParts() []*ContentPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *LLMMessage) GetToolCalls() []*ToolCall {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+func (x *LLMMessage) GetToolCallId() string {
+	if x != nil && x.ToolCallId != nil {
+		return *x.ToolCallId
+	}
+	return ""
+}
+
+type ToolDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// JSON schema of the arguments object
+	ParametersJson string `protobuf:"bytes,3,opt,name=parameters_json,json=parametersJson,proto3" json:"parameters_json,omitempty"`
+}
+
+func (x *ToolDefinition) Reset() {
+	*x = ToolDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolDefinition) ProtoMessage() {}
+
+func (x *ToolDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolDefinition.ProtoReflect.Descriptor instead.
+func (*ToolDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ToolDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ToolDefinition) GetParametersJson() string {
+	if x != nil {
+		return x.ParametersJson
+	}
+	return ""
+}
+
+type ToolCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Arguments as a JSON object
+	ArgumentsJson string `protobuf:"bytes,3,opt,name=arguments_json,json=argumentsJson,proto3" json:"arguments_json,omitempty"`
+}
+
+func (x *ToolCall) Reset() {
+	*x = ToolCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall) ProtoMessage() {}
+
+func (x *ToolCall) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
+func (*ToolCall) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ToolCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToolCall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCall) GetArgumentsJson() string {
+	if x != nil {
+		return x.ArgumentsJson
+	}
+	return ""
+}
+
+type CreateLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config *LLMConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *CreateLLMRequest) Reset() {
+	*x = CreateLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLLMRequest) ProtoMessage() {}
+
+func (x *CreateLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLLMRequest.ProtoReflect.Descriptor instead.
+func (*CreateLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLLMRequest) GetConfig() *LLMConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CreateLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CreateLLMResponse) Reset() {
+	*x = CreateLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLLMResponse) ProtoMessage() {}
+
+func (x *CreateLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLLMResponse.ProtoReflect.Descriptor instead.
+func (*CreateLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ResponseFormat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       ResponseFormatType `protobuf:"varint,1,opt,name=type,proto3,enum=operrouter.v1.ResponseFormatType" json:"type,omitempty"`
+	SchemaName string             `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	SchemaJson string             `protobuf:"bytes,3,opt,name=schema_json,json=schemaJson,proto3" json:"schema_json,omitempty"`
+	// Ask the provider to enforce the schema exactly, where supported
+	Strict bool `protobuf:"varint,4,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *ResponseFormat) Reset() {
+	*x = ResponseFormat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseFormat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseFormat) ProtoMessage() {}
+
+func (x *ResponseFormat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseFormat.ProtoReflect.Descriptor instead.
+func (*ResponseFormat) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ResponseFormat) GetType() ResponseFormatType {
+	if x != nil {
+		return x.Type
+	}
+	return ResponseFormatType_RESPONSE_FORMAT_TYPE_UNSPECIFIED
+}
+
+func (x *ResponseFormat) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *ResponseFormat) GetSchemaJson() string {
+	if x != nil {
+		return x.SchemaJson
+	}
+	return ""
+}
+
+func (x *ResponseFormat) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+// Per-request settings; unset fields keep the values given to CreateLLM
+type GenerationOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Temperature      *float32        `protobuf:"fixed32,1,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	TopP             *float32        `protobuf:"fixed32,2,opt,name=top_p,json=topP,proto3,oneof" json:"top_p,omitempty"`
+	MaxTokens        *uint32         `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
+	Stop             []string        `protobuf:"bytes,4,rep,name=stop,proto3" json:"stop,omitempty"`
+	Seed             *int64          `protobuf:"varint,5,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	PresencePenalty  *float32        `protobuf:"fixed32,6,opt,name=presence_penalty,json=presencePenalty,proto3,oneof" json:"presence_penalty,omitempty"`
+	FrequencyPenalty *float32        `protobuf:"fixed32,7,opt,name=frequency_penalty,json=frequencyPenalty,proto3,oneof" json:"frequency_penalty,omitempty"`
+	ResponseFormat   *ResponseFormat `protobuf:"bytes,8,opt,name=response_format,json=responseFormat,proto3" json:"response_format,omitempty"`
+}
+
+func (x *GenerationOptions) Reset() {
+	*x = GenerationOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerationOptions) ProtoMessage() {}
+
+func (x *GenerationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerationOptions.ProtoReflect.Descriptor instead.
+func (*GenerationOptions) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GenerationOptions) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *GenerationOptions) GetTopP() float32 {
+	if x != nil && x.TopP != nil {
+		return *x.TopP
+	}
+	return 0
+}
+
+func (x *GenerationOptions) GetMaxTokens() uint32 {
+	if x != nil && x.MaxTokens != nil {
+		return *x.MaxTokens
+	}
+	return 0
+}
+
+func (x *GenerationOptions) GetStop() []string {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+func (x *GenerationOptions) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+func (x *GenerationOptions) GetPresencePenalty() float32 {
+	if x != nil && x.PresencePenalty != nil {
+		return *x.PresencePenalty
+	}
+	return 0
+}
+
+func (x *GenerationOptions) GetFrequencyPenalty() float32 {
+	if x != nil && x.FrequencyPenalty != nil {
+		return *x.FrequencyPenalty
+	}
+	return 0
+}
+
+func (x *GenerationOptions) GetResponseFormat() *ResponseFormat {
+	if x != nil {
+		return x.ResponseFormat
+	}
+	return nil
+}
+
+type GenerateLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prompt  string             `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Options *GenerationOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GenerateLLMRequest) Reset() {
+	*x = GenerateLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLLMRequest) ProtoMessage() {}
+
+func (x *GenerateLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLLMRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GenerateLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenerateLLMRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *GenerateLLMRequest) GetOptions() *GenerationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GenerateLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Text         string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	TokensUsed   *uint32 `protobuf:"varint,3,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
+	FinishReason *string `protobuf:"bytes,4,opt,name=finish_reason,json=finishReason,proto3,oneof" json:"finish_reason,omitempty"`
+	Model        string  `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	Error        string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GenerateLLMResponse) Reset() {
+	*x = GenerateLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLLMResponse) ProtoMessage() {}
+
+func (x *GenerateLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLLMResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GenerateLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GenerateLLMResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *GenerateLLMResponse) GetTokensUsed() uint32 {
+	if x != nil && x.TokensUsed != nil {
+		return *x.TokensUsed
+	}
+	return 0
+}
+
+func (x *GenerateLLMResponse) GetFinishReason() string {
+	if x != nil && x.FinishReason != nil {
+		return *x.FinishReason
+	}
+	return ""
+}
+
+func (x *GenerateLLMResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GenerateLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ChatLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Messages []*LLMMessage     `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Tools    []*ToolDefinition `protobuf:"bytes,3,rep,name=tools,proto3" json:"tools,omitempty"`
+	// "auto" (default), "none", "required" or the name of a tool to call
+	ToolChoice string             `protobuf:"bytes,4,opt,name=tool_choice,json=toolChoice,proto3" json:"tool_choice,omitempty"`
+	Options    *GenerationOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ChatLLMRequest) Reset() {
+	*x = ChatLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatLLMRequest) ProtoMessage() {}
+
+func (x *ChatLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatLLMRequest.ProtoReflect.Descriptor instead.
+func (*ChatLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ChatLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatLLMRequest) GetMessages() []*LLMMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ChatLLMRequest) GetTools() []*ToolDefinition {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *ChatLLMRequest) GetToolChoice() string {
+	if x != nil {
+		return x.ToolChoice
+	}
+	return ""
+}
+
+func (x *ChatLLMRequest) GetOptions() *GenerationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ChatLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Text         string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	TokensUsed   *uint32 `protobuf:"varint,3,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
+	FinishReason *string `protobuf:"bytes,4,opt,name=finish_reason,json=finishReason,proto3,oneof" json:"finish_reason,omitempty"`
+	Model        string  `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	Error        string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Calls the model asks the caller to run
+	ToolCalls []*ToolCall `protobuf:"bytes,7,rep,name=tool_calls,json=toolCalls,proto3" json:"tool_calls,omitempty"`
+}
+
+func (x *ChatLLMResponse) Reset() {
+	*x = ChatLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatLLMResponse) ProtoMessage() {}
+
+func (x *ChatLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatLLMResponse.ProtoReflect.Descriptor instead.
+func (*ChatLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ChatLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChatLLMResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatLLMResponse) GetTokensUsed() uint32 {
+	if x != nil && x.TokensUsed != nil {
+		return *x.TokensUsed
+	}
+	return 0
+}
+
+func (x *ChatLLMResponse) GetFinishReason() string {
+	if x != nil && x.FinishReason != nil {
+		return *x.FinishReason
+	}
+	return ""
+}
+
+func (x *ChatLLMResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ChatLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChatLLMResponse) GetToolCalls() []*ToolCall {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+type EmbeddingLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *EmbeddingLLMRequest) Reset() {
+	*x = EmbeddingLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmbeddingLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingLLMRequest) ProtoMessage() {}
+
+func (x *EmbeddingLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingLLMRequest.ProtoReflect.Descriptor instead.
+func (*EmbeddingLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EmbeddingLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmbeddingLLMRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type EmbeddingLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Embedding  []float32 `protobuf:"fixed32,2,rep,packed,name=embedding,proto3" json:"embedding,omitempty"`
+	Model      string    `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	TokensUsed *uint32   `protobuf:"varint,4,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
+	Error      string    `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EmbeddingLLMResponse) Reset() {
+	*x = EmbeddingLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmbeddingLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingLLMResponse) ProtoMessage() {}
+
+func (x *EmbeddingLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingLLMResponse.ProtoReflect.Descriptor instead.
+func (*EmbeddingLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EmbeddingLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EmbeddingLLMResponse) GetEmbedding() []float32 {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+func (x *EmbeddingLLMResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *EmbeddingLLMResponse) GetTokensUsed() uint32 {
+	if x != nil && x.TokensUsed != nil {
+		return *x.TokensUsed
+	}
+	return 0
+}
+
+func (x *EmbeddingLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchEmbeddingLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Texts []string `protobuf:"bytes,2,rep,name=texts,proto3" json:"texts,omitempty"`
+}
+
+func (x *BatchEmbeddingLLMRequest) Reset() {
+	*x = BatchEmbeddingLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchEmbeddingLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEmbeddingLLMRequest) ProtoMessage() {}
+
+func (x *BatchEmbeddingLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEmbeddingLLMRequest.ProtoReflect.Descriptor instead.
+func (*BatchEmbeddingLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BatchEmbeddingLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchEmbeddingLLMRequest) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+// Embedding of one input text; error is set instead when it failed
+type EmbeddingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Embedding []float32 `protobuf:"fixed32,1,rep,packed,name=embedding,proto3" json:"embedding,omitempty"`
+	Error     string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EmbeddingResult) Reset() {
+	*x = EmbeddingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmbeddingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingResult) ProtoMessage() {}
+
+func (x *EmbeddingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingResult.ProtoReflect.Descriptor instead.
+func (*EmbeddingResult) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *EmbeddingResult) GetEmbedding() []float32 {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+func (x *EmbeddingResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchEmbeddingLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// One result per input text, in input order
+	Results    []*EmbeddingResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Model      string             `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	TokensUsed *uint32            `protobuf:"varint,4,opt,name=tokens_used,json=tokensUsed,proto3,oneof" json:"tokens_used,omitempty"`
+	Error      string             `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchEmbeddingLLMResponse) Reset() {
+	*x = BatchEmbeddingLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchEmbeddingLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEmbeddingLLMResponse) ProtoMessage() {}
+
+func (x *BatchEmbeddingLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEmbeddingLLMResponse.ProtoReflect.Descriptor instead.
+func (*BatchEmbeddingLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BatchEmbeddingLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchEmbeddingLLMResponse) GetResults() []*EmbeddingResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchEmbeddingLLMResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *BatchEmbeddingLLMResponse) GetTokensUsed() uint32 {
+	if x != nil && x.TokensUsed != nil {
+		return *x.TokensUsed
+	}
+	return 0
+}
+
+func (x *BatchEmbeddingLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StreamLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prompt  string             `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Options *GenerationOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *StreamLLMRequest) Reset() {
+	*x = StreamLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLLMRequest) ProtoMessage() {}
+
+func (x *StreamLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLLMRequest.ProtoReflect.Descriptor instead.
+func (*StreamLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StreamLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamLLMRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *StreamLLMRequest) GetOptions() *GenerationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type StreamLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Chunk   string `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Done    bool   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Error   string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StreamLLMResponse) Reset() {
+	*x = StreamLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLLMResponse) ProtoMessage() {}
+
+func (x *StreamLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLLMResponse.ProtoReflect.Descriptor instead.
+func (*StreamLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *StreamLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StreamLLMResponse) GetChunk() string {
+	if x != nil {
+		return x.Chunk
+	}
+	return ""
+}
+
+func (x *StreamLLMResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *StreamLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PingLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PingLLMRequest) Reset() {
+	*x = PingLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingLLMRequest) ProtoMessage() {}
+
+func (x *PingLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingLLMRequest.ProtoReflect.Descriptor instead.
+func (*PingLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PingLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PingLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Healthy bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PingLLMResponse) Reset() {
+	*x = PingLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingLLMResponse) ProtoMessage() {}
+
+func (x *PingLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingLLMResponse.ProtoReflect.Descriptor instead.
+func (*PingLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PingLLMResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *PingLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CloseLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CloseLLMRequest) Reset() {
+	*x = CloseLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLLMRequest) ProtoMessage() {}
+
+func (x *CloseLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLLMRequest.ProtoReflect.Descriptor instead.
+func (*CloseLLMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CloseLLMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CloseLLMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CloseLLMResponse) Reset() {
+	*x = CloseLLMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_operrouter_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseLLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLLMResponse) ProtoMessage() {}
+
+func (x *CloseLLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_operrouter_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLLMResponse.ProtoReflect.Descriptor instead.
+func (*CloseLLMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_operrouter_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CloseLLMResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CloseLLMResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_operrouter_proto protoreflect.FileDescriptor
+
+var file_proto_operrouter_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x5a, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x40, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x6d, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6d, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x46, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x69,
+	0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd7, 0x02, 0x0a, 0x10,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x40, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xdb, 0x02, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x61,
+	0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x61,
+	0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x9e, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x3e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a,
+	0x4f, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x92, 0x01, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x1a, 0x50, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4a, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbc, 0x02, 0x0a, 0x16, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x28,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x52, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77,
+	0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x28, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x59, 0x0a, 0x0a, 0x6e,
+	0x61, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x1a, 0x52, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc0, 0x02, 0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x55, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x52, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x72, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x26, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6f,
+	0x0a, 0x18, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x2b, 0x0a, 0x15, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x16,
+	0x50, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x16, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x87, 0x01, 0x0a, 0x17, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x3b, 0x0a, 0x09, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x09, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x71, 0x0a, 0x18, 0x42, 0x65, 0x67,
+	0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x55, 0x0a, 0x18,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x57, 0x0a, 0x1a, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x1b, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22,
+	0x3f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x22, 0x76, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x30, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xad, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x35, 0x0a, 0x0b, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x4b, 0x61, 0x66, 0x6b, 0x61,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x63, 0x6b, 0x73,
+	0x52, 0x04, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xa4, 0x01, 0x0a, 0x15, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x0c, 0x4b, 0x61, 0x66, 0x6b,
+	0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x4d, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x62, 0x0a, 0x14, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x82, 0x01,
+	0x0a, 0x12, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x09, 0x53, 0x6f, 0x72,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xab, 0x02, 0x0a,
+	0x10, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x04,
+	0x73, 0x6b, 0x69, 0x70, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x7d, 0x0a, 0x11, 0x4d, 0x6f,
+	0x6e, 0x67, 0x6f, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x4d, 0x6f,
+	0x6e, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7e,
+	0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdc,
+	0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6d, 0x61, 0x6e, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x22, 0xdd, 0x01,
+	0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x75,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x90, 0x01,
+	0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x61, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x61, 0x6e, 0x79,
+	0x22, 0x6a, 0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd1, 0x03, 0x0a,
+	0x09, 0x4c, 0x4c, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x61, 0x70, 0x69, 0x42, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x0b, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0e, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x4c, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x4e, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x5d, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x22,
+	0x9a, 0x02, 0x0a, 0x0a, 0x4c, 0x4c, 0x4d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x52, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x74,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x0e,
+	0x54, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x55, 0x0a,
+	0x08, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x43,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4a, 0x73, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0xac, 0x03, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x50, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x2e, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x05, 0x52, 0x10, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x46, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f,
+	0x70, 0x5f, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x7c, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x61,
+	0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x3d, 0x0a, 0x13, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xb0, 0x01, 0x0a, 0x14, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x09, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0x44, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x0f, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x09,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xd1, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x6d, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24,
+	0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42,
+	0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x2a, 0xc5, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x59, 0x53, 0x51,
+	0x4c, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53,
+	0x51, 0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x53, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x47, 0x4f, 0x44, 0x42, 0x10, 0x05, 0x2a, 0xc2, 0x01, 0x0a, 0x0e, 0x49,
+	0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a,
+	0x1b, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24,
+	0x0a, 0x20, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x53, 0x4f, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x45,
+	0x41, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a,
+	0x1c, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x2a,
+	0x6d, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x41, 0x42, 0x4c,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x56, 0x49, 0x45,
+	0x57, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x67,
+	0x0a, 0x09, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x4b,
+	0x41, 0x46, 0x4b, 0x41, 0x5f, 0x41, 0x43, 0x4b, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4b, 0x41, 0x46, 0x4b, 0x41,
+	0x5f, 0x41, 0x43, 0x4b, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x4b, 0x41, 0x46, 0x4b, 0x41, 0x5f, 0x41, 0x43, 0x4b, 0x53, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x5f, 0x41, 0x43, 0x4b,
+	0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x91, 0x01, 0x0a, 0x0b, 0x4c, 0x4c, 0x4d, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x4c, 0x4d, 0x5f, 0x50,
+	0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4c, 0x4d, 0x5f, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x49, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x4c, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4f,
+	0x4c, 0x4c, 0x41, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x4c, 0x4d, 0x5f, 0x50,
+	0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x54, 0x48, 0x52, 0x4f, 0x50, 0x49,
+	0x43, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4c, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49,
+	0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0x8e, 0x01, 0x0a, 0x0b,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x53, 0x54,
+	0x41, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x4c, 0x10, 0x04, 0x2a, 0xa5, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50,
+	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x24,
+	0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x41, 0x10, 0x03, 0x32, 0x86, 0x16, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x6f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x72,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x11, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27,
+	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x63, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x11,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x0c, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x24, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0b, 0x4b,
+	0x61, 0x66, 0x6b, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4e, 0x0a, 0x09, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x1f,
+	0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x67, 0x6f, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x67, 0x6f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0b, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x67, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d,
+	0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c,
+	0x4c, 0x4d, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x4c,
+	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x43, 0x68, 0x61,
+	0x74, 0x4c, 0x4c, 0x4d, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x4c, 0x4d, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x11,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c,
+	0x4d, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c,
+	0x4d, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c,
+	0x4d, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x12, 0x1e, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f,
+	0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa8, 0x01,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x42, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x6f,
+	0x2d, 0x6f, 0x70, 0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4f, 0x70,
+	0x65, 0x72, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_operrouter_proto_rawDescOnce sync.Once
+	file_proto_operrouter_proto_rawDescData = file_proto_operrouter_proto_rawDesc
+)
+
+func file_proto_operrouter_proto_rawDescGZIP() []byte {
+	file_proto_operrouter_proto_rawDescOnce.Do(func() {
+		file_proto_operrouter_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_operrouter_proto_rawDescData)
+	})
+	return file_proto_operrouter_proto_rawDescData
+}
+
+var file_proto_operrouter_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_operrouter_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_proto_operrouter_proto_goTypes = []any{
+	(DataSourceType)(0),                   // 0: operrouter.v1.DataSourceType
+	(IsolationLevel)(0),                   // 1: operrouter.v1.IsolationLevel
+	(TableKind)(0),                        // 2: operrouter.v1.TableKind
+	(KafkaAcks)(0),                        // 3: operrouter.v1.KafkaAcks
+	(LLMProvider)(0),                      // 4: operrouter.v1.LLMProvider
+	(MessageRole)(0),                      // 5: operrouter.v1.MessageRole
+	(ResponseFormatType)(0),               // 6: operrouter.v1.ResponseFormatType
+	(*Metadata)(nil),                      // 7: operrouter.v1.Metadata
+	(*PingRequest)(nil),                   // 8: operrouter.v1.PingRequest
+	(*PingResponse)(nil),                  // 9: operrouter.v1.PingResponse
+	(*ValidateConfigRequest)(nil),         // 10: operrouter.v1.ValidateConfigRequest
+	(*ValidateConfigResponse)(nil),        // 11: operrouter.v1.ValidateConfigResponse
+	(*LoadConfigRequest)(nil),             // 12: operrouter.v1.LoadConfigRequest
+	(*LoadConfigResponse)(nil),            // 13: operrouter.v1.LoadConfigResponse
+	(*GetMetadataRequest)(nil),            // 14: operrouter.v1.GetMetadataRequest
+	(*GetMetadataResponse)(nil),           // 15: operrouter.v1.GetMetadataResponse
+	(*DataSourceConfig)(nil),              // 16: operrouter.v1.DataSourceConfig
+	(*Value)(nil),                         // 17: operrouter.v1.Value
+	(*ValueArray)(nil),                    // 18: operrouter.v1.ValueArray
+	(*ValueObject)(nil),                   // 19: operrouter.v1.ValueObject
+	(*Row)(nil),                           // 20: operrouter.v1.Row
+	(*CreateDataSourceRequest)(nil),       // 21: operrouter.v1.CreateDataSourceRequest
+	(*CreateDataSourceResponse)(nil),      // 22: operrouter.v1.CreateDataSourceResponse
+	(*QueryDataSourceRequest)(nil),        // 23: operrouter.v1.QueryDataSourceRequest
+	(*QueryDataSourceResponse)(nil),       // 24: operrouter.v1.QueryDataSourceResponse
+	(*StreamQueryDataSourceRequest)(nil),  // 25: operrouter.v1.StreamQueryDataSourceRequest
+	(*StreamQueryDataSourceResponse)(nil), // 26: operrouter.v1.StreamQueryDataSourceResponse
+	(*ExecuteDataSourceRequest)(nil),      // 27: operrouter.v1.ExecuteDataSourceRequest
+	(*ExecuteDataSourceResponse)(nil),     // 28: operrouter.v1.ExecuteDataSourceResponse
+	(*InsertDataSourceRequest)(nil),       // 29: operrouter.v1.InsertDataSourceRequest
+	(*InsertDataSourceResponse)(nil),      // 30: operrouter.v1.InsertDataSourceResponse
+	(*PingDataSourceRequest)(nil),         // 31: operrouter.v1.PingDataSourceRequest
+	(*PingDataSourceResponse)(nil),        // 32: operrouter.v1.PingDataSourceResponse
+	(*CloseDataSourceRequest)(nil),        // 33: operrouter.v1.CloseDataSourceRequest
+	(*CloseDataSourceResponse)(nil),       // 34: operrouter.v1.CloseDataSourceResponse
+	(*BeginTransactionRequest)(nil),       // 35: operrouter.v1.BeginTransactionRequest
+	(*BeginTransactionResponse)(nil),      // 36: operrouter.v1.BeginTransactionResponse
+	(*CommitTransactionRequest)(nil),      // 37: operrouter.v1.CommitTransactionRequest
+	(*CommitTransactionResponse)(nil),     // 38: operrouter.v1.CommitTransactionResponse
+	(*RollbackTransactionRequest)(nil),    // 39: operrouter.v1.RollbackTransactionRequest
+	(*RollbackTransactionResponse)(nil),   // 40: operrouter.v1.RollbackTransactionResponse
+	(*TableInfo)(nil),                     // 41: operrouter.v1.TableInfo
+	(*ListTablesRequest)(nil),             // 42: operrouter.v1.ListTablesRequest
+	(*ListTablesResponse)(nil),            // 43: operrouter.v1.ListTablesResponse
+	(*ColumnInfo)(nil),                    // 44: operrouter.v1.ColumnInfo
+	(*DescribeTableRequest)(nil),          // 45: operrouter.v1.DescribeTableRequest
+	(*DescribeTableResponse)(nil),         // 46: operrouter.v1.DescribeTableResponse
+	(*KafkaHeader)(nil),                   // 47: operrouter.v1.KafkaHeader
+	(*KafkaRecord)(nil),                   // 48: operrouter.v1.KafkaRecord
+	(*KafkaPublishRequest)(nil),           // 49: operrouter.v1.KafkaPublishRequest
+	(*KafkaDeliveryReport)(nil),           // 50: operrouter.v1.KafkaDeliveryReport
+	(*KafkaPublishResponse)(nil),          // 51: operrouter.v1.KafkaPublishResponse
+	(*KafkaSubscribeRequest)(nil),         // 52: operrouter.v1.KafkaSubscribeRequest
+	(*KafkaMessage)(nil),                  // 53: operrouter.v1.KafkaMessage
+	(*KafkaSubscribeResponse)(nil),        // 54: operrouter.v1.KafkaSubscribeResponse
+	(*KafkaPartitionOffset)(nil),          // 55: operrouter.v1.KafkaPartitionOffset
+	(*KafkaCommitRequest)(nil),            // 56: operrouter.v1.KafkaCommitRequest
+	(*KafkaCommitResponse)(nil),           // 57: operrouter.v1.KafkaCommitResponse
+	(*SortField)(nil),                     // 58: operrouter.v1.SortField
+	(*MongoFindRequest)(nil),              // 59: operrouter.v1.MongoFindRequest
+	(*MongoFindResponse)(nil),             // 60: operrouter.v1.MongoFindResponse
+	(*MongoInsertRequest)(nil),            // 61: operrouter.v1.MongoInsertRequest
+	(*MongoInsertResponse)(nil),           // 62: operrouter.v1.MongoInsertResponse
+	(*MongoUpdateRequest)(nil),            // 63: operrouter.v1.MongoUpdateRequest
+	(*MongoUpdateResponse)(nil),           // 64: operrouter.v1.MongoUpdateResponse
+	(*MongoDeleteRequest)(nil),            // 65: operrouter.v1.MongoDeleteRequest
+	(*MongoDeleteResponse)(nil),           // 66: operrouter.v1.MongoDeleteResponse
+	(*LLMConfig)(nil),                     // 67: operrouter.v1.LLMConfig
+	(*ImagePart)(nil),                     // 68: operrouter.v1.ImagePart
+	(*ContentPart)(nil),                   // 69: operrouter.v1.ContentPart
+	(*LLMMessage)(nil),                    // 70: operrouter.v1.LLMMessage
+	(*ToolDefinition)(nil),                // 71: operrouter.v1.ToolDefinition
+	(*ToolCall)(nil),                      // 72: operrouter.v1.ToolCall
+	(*CreateLLMRequest)(nil),              // 73: operrouter.v1.CreateLLMRequest
+	(*CreateLLMResponse)(nil),             // 74: operrouter.v1.CreateLLMResponse
+	(*ResponseFormat)(nil),                // 75: operrouter.v1.ResponseFormat
+	(*GenerationOptions)(nil),             // 76: operrouter.v1.GenerationOptions
+	(*GenerateLLMRequest)(nil),            // 77: operrouter.v1.GenerateLLMRequest
+	(*GenerateLLMResponse)(nil),           // 78: operrouter.v1.GenerateLLMResponse
+	(*ChatLLMRequest)(nil),                // 79: operrouter.v1.ChatLLMRequest
+	(*ChatLLMResponse)(nil),               // 80: operrouter.v1.ChatLLMResponse
+	(*EmbeddingLLMRequest)(nil),           // 81: operrouter.v1.EmbeddingLLMRequest
+	(*EmbeddingLLMResponse)(nil),          // 82: operrouter.v1.EmbeddingLLMResponse
+	(*BatchEmbeddingLLMRequest)(nil),      // 83: operrouter.v1.BatchEmbeddingLLMRequest
+	(*EmbeddingResult)(nil),               // 84: operrouter.v1.EmbeddingResult
+	(*BatchEmbeddingLLMResponse)(nil),     // 85: operrouter.v1.BatchEmbeddingLLMResponse
+	(*StreamLLMRequest)(nil),              // 86: operrouter.v1.StreamLLMRequest
+	(*StreamLLMResponse)(nil),             // 87: operrouter.v1.StreamLLMResponse
+	(*PingLLMRequest)(nil),                // 88: operrouter.v1.PingLLMRequest
+	(*PingLLMResponse)(nil),               // 89: operrouter.v1.PingLLMResponse
+	(*CloseLLMRequest)(nil),               // 90: operrouter.v1.CloseLLMRequest
+	(*CloseLLMResponse)(nil),              // 91: operrouter.v1.CloseLLMResponse
+	nil,                                   // 92: operrouter.v1.DataSourceConfig.ExtraEntry
+	nil,                                   // 93: operrouter.v1.ValueObject.FieldsEntry
+	nil,                                   // 94: operrouter.v1.Row.ColumnsEntry
+	nil,                                   // 95: operrouter.v1.QueryDataSourceRequest.NamedArgsEntry
+	nil,                                   // 96: operrouter.v1.StreamQueryDataSourceRequest.NamedArgsEntry
+	nil,                                   // 97: operrouter.v1.ExecuteDataSourceRequest.NamedArgsEntry
+	nil,                                   // 98: operrouter.v1.LLMConfig.ExtraEntry
+}
+var file_proto_operrouter_proto_depIdxs = []int32{
+	7,  // 0: operrouter.v1.GetMetadataResponse.metadata:type_name -> operrouter.v1.Metadata
+	0,  // 1: operrouter.v1.DataSourceConfig.type:type_name -> operrouter.v1.DataSourceType
+	92, // 2: operrouter.v1.DataSourceConfig.extra:type_name -> operrouter.v1.DataSourceConfig.ExtraEntry
+	18, // 3: operrouter.v1.Value.array_value:type_name -> operrouter.v1.ValueArray
+	19, // 4: operrouter.v1.Value.object_value:type_name -> operrouter.v1.ValueObject
+	17, // 5: operrouter.v1.ValueArray.values:type_name -> operrouter.v1.Value
+	93, // 6: operrouter.v1.ValueObject.fields:type_name -> operrouter.v1.ValueObject.FieldsEntry
+	94, // 7: operrouter.v1.Row.columns:type_name -> operrouter.v1.Row.ColumnsEntry
+	16, // 8: operrouter.v1.CreateDataSourceRequest.config:type_name -> operrouter.v1.DataSourceConfig
+	17, // 9: operrouter.v1.QueryDataSourceRequest.args:type_name -> operrouter.v1.Value
+	95, // 10: operrouter.v1.QueryDataSourceRequest.named_args:type_name -> operrouter.v1.QueryDataSourceRequest.NamedArgsEntry
+	20, // 11: operrouter.v1.QueryDataSourceResponse.rows:type_name -> operrouter.v1.Row
+	17, // 12: operrouter.v1.StreamQueryDataSourceRequest.args:type_name -> operrouter.v1.Value
+	96, // 13: operrouter.v1.StreamQueryDataSourceRequest.named_args:type_name -> operrouter.v1.StreamQueryDataSourceRequest.NamedArgsEntry
+	20, // 14: operrouter.v1.StreamQueryDataSourceResponse.rows:type_name -> operrouter.v1.Row
+	17, // 15: operrouter.v1.ExecuteDataSourceRequest.args:type_name -> operrouter.v1.Value
+	97, // 16: operrouter.v1.ExecuteDataSourceRequest.named_args:type_name -> operrouter.v1.ExecuteDataSourceRequest.NamedArgsEntry
+	20, // 17: operrouter.v1.ExecuteDataSourceResponse.rows:type_name -> operrouter.v1.Row
+	20, // 18: operrouter.v1.InsertDataSourceRequest.data:type_name -> operrouter.v1.Row
+	20, // 19: operrouter.v1.InsertDataSourceRequest.rows:type_name -> operrouter.v1.Row
+	1,  // 20: operrouter.v1.BeginTransactionRequest.isolation:type_name -> operrouter.v1.IsolationLevel
+	2,  // 21: operrouter.v1.TableInfo.kind:type_name -> operrouter.v1.TableKind
+	41, // 22: operrouter.v1.ListTablesResponse.tables:type_name -> operrouter.v1.TableInfo
+	41, // 23: operrouter.v1.DescribeTableResponse.table:type_name -> operrouter.v1.TableInfo
+	44, // 24: operrouter.v1.DescribeTableResponse.columns:type_name -> operrouter.v1.ColumnInfo
+	47, // 25: operrouter.v1.KafkaRecord.headers:type_name -> operrouter.v1.KafkaHeader
+	48, // 26: operrouter.v1.KafkaPublishRequest.records:type_name -> operrouter.v1.KafkaRecord
+	3,  // 27: operrouter.v1.KafkaPublishRequest.acks:type_name -> operrouter.v1.KafkaAcks
+	50, // 28: operrouter.v1.KafkaPublishResponse.reports:type_name -> operrouter.v1.KafkaDeliveryReport
+	47, // 29: operrouter.v1.KafkaMessage.headers:type_name -> operrouter.v1.KafkaHeader
+	53, // 30: operrouter.v1.KafkaSubscribeResponse.messages:type_name -> operrouter.v1.KafkaMessage
+	55, // 31: operrouter.v1.KafkaCommitRequest.offsets:type_name -> operrouter.v1.KafkaPartitionOffset
+	19, // 32: operrouter.v1.MongoFindRequest.filter:type_name -> operrouter.v1.ValueObject
+	19, // 33: operrouter.v1.MongoFindRequest.projection:type_name -> operrouter.v1.ValueObject
+	58, // 34: operrouter.v1.MongoFindRequest.sort:type_name -> operrouter.v1.SortField
+	19, // 35: operrouter.v1.MongoFindResponse.documents:type_name -> operrouter.v1.ValueObject
+	19, // 36: operrouter.v1.MongoInsertRequest.documents:type_name -> operrouter.v1.ValueObject
+	17, // 37: operrouter.v1.MongoInsertResponse.inserted_ids:type_name -> operrouter.v1.Value
+	19, // 38: operrouter.v1.MongoUpdateRequest.filter:type_name -> operrouter.v1.ValueObject
+	19, // 39: operrouter.v1.MongoUpdateRequest.update:type_name -> operrouter.v1.ValueObject
+	17, // 40: operrouter.v1.MongoUpdateResponse.upserted_id:type_name -> operrouter.v1.Value
+	19, // 41: operrouter.v1.MongoDeleteRequest.filter:type_name -> operrouter.v1.ValueObject
+	4,  // 42: operrouter.v1.LLMConfig.provider:type_name -> operrouter.v1.LLMProvider
+	98, // 43: operrouter.v1.LLMConfig.extra:type_name -> operrouter.v1.LLMConfig.ExtraEntry
+	68, // 44: operrouter.v1.ContentPart.image:type_name -> operrouter.v1.ImagePart
+	5,  // 45: operrouter.v1.LLMMessage.role:type_name -> operrouter.v1.MessageRole
+	69, // 46: operrouter.v1.LLMMessage.parts:type_name -> operrouter.v1.ContentPart
+	72, // 47: operrouter.v1.LLMMessage.tool_calls:type_name -> operrouter.v1.ToolCall
+	67, // 48: operrouter.v1.CreateLLMRequest.config:type_name -> operrouter.v1.LLMConfig
+	6,  // 49: operrouter.v1.ResponseFormat.type:type_name -> operrouter.v1.ResponseFormatType
+	75, // 50: operrouter.v1.GenerationOptions.response_format:type_name -> operrouter.v1.ResponseFormat
+	76, // 51: operrouter.v1.GenerateLLMRequest.options:type_name -> operrouter.v1.GenerationOptions
+	70, // 52: operrouter.v1.ChatLLMRequest.messages:type_name -> operrouter.v1.LLMMessage
+	71, // 53: operrouter.v1.ChatLLMRequest.tools:type_name -> operrouter.v1.ToolDefinition
+	76, // 54: operrouter.v1.ChatLLMRequest.options:type_name -> operrouter.v1.GenerationOptions
+	72, // 55: operrouter.v1.ChatLLMResponse.tool_calls:type_name -> operrouter.v1.ToolCall
+	84, // 56: operrouter.v1.BatchEmbeddingLLMResponse.results:type_name -> operrouter.v1.EmbeddingResult
+	76, // 57: operrouter.v1.StreamLLMRequest.options:type_name -> operrouter.v1.GenerationOptions
+	17, // 58: operrouter.v1.ValueObject.FieldsEntry.value:type_name -> operrouter.v1.Value
+	17, // 59: operrouter.v1.Row.ColumnsEntry.value:type_name -> operrouter.v1.Value
+	17, // 60: operrouter.v1.QueryDataSourceRequest.NamedArgsEntry.value:type_name -> operrouter.v1.Value
+	17, // 61: operrouter.v1.StreamQueryDataSourceRequest.NamedArgsEntry.value:type_name -> operrouter.v1.Value
+	17, // 62: operrouter.v1.ExecuteDataSourceRequest.NamedArgsEntry.value:type_name -> operrouter.v1.Value
+	8,  // 63: operrouter.v1.OperRouter.Ping:input_type -> operrouter.v1.PingRequest
+	10, // 64: operrouter.v1.OperRouter.ValidateConfig:input_type -> operrouter.v1.ValidateConfigRequest
+	12, // 65: operrouter.v1.OperRouter.LoadConfig:input_type -> operrouter.v1.LoadConfigRequest
+	14, // 66: operrouter.v1.OperRouter.GetMetadata:input_type -> operrouter.v1.GetMetadataRequest
+	21, // 67: operrouter.v1.OperRouter.CreateDataSource:input_type -> operrouter.v1.CreateDataSourceRequest
+	23, // 68: operrouter.v1.OperRouter.QueryDataSource:input_type -> operrouter.v1.QueryDataSourceRequest
+	25, // 69: operrouter.v1.OperRouter.StreamQueryDataSource:input_type -> operrouter.v1.StreamQueryDataSourceRequest
+	27, // 70: operrouter.v1.OperRouter.ExecuteDataSource:input_type -> operrouter.v1.ExecuteDataSourceRequest
+	29, // 71: operrouter.v1.OperRouter.InsertDataSource:input_type -> operrouter.v1.InsertDataSourceRequest
+	31, // 72: operrouter.v1.OperRouter.PingDataSource:input_type -> operrouter.v1.PingDataSourceRequest
+	33, // 73: operrouter.v1.OperRouter.CloseDataSource:input_type -> operrouter.v1.CloseDataSourceRequest
+	35, // 74: operrouter.v1.OperRouter.BeginTransaction:input_type -> operrouter.v1.BeginTransactionRequest
+	37, // 75: operrouter.v1.OperRouter.CommitTransaction:input_type -> operrouter.v1.CommitTransactionRequest
+	39, // 76: operrouter.v1.OperRouter.RollbackTransaction:input_type -> operrouter.v1.RollbackTransactionRequest
+	42, // 77: operrouter.v1.OperRouter.ListTables:input_type -> operrouter.v1.ListTablesRequest
+	45, // 78: operrouter.v1.OperRouter.DescribeTable:input_type -> operrouter.v1.DescribeTableRequest
+	49, // 79: operrouter.v1.OperRouter.KafkaPublish:input_type -> operrouter.v1.KafkaPublishRequest
+	52, // 80: operrouter.v1.OperRouter.KafkaSubscribe:input_type -> operrouter.v1.KafkaSubscribeRequest
+	56, // 81: operrouter.v1.OperRouter.KafkaCommit:input_type -> operrouter.v1.KafkaCommitRequest
+	59, // 82: operrouter.v1.OperRouter.MongoFind:input_type -> operrouter.v1.MongoFindRequest
+	61, // 83: operrouter.v1.OperRouter.MongoInsert:input_type -> operrouter.v1.MongoInsertRequest
+	63, // 84: operrouter.v1.OperRouter.MongoUpdate:input_type -> operrouter.v1.MongoUpdateRequest
+	65, // 85: operrouter.v1.OperRouter.MongoDelete:input_type -> operrouter.v1.MongoDeleteRequest
+	73, // 86: operrouter.v1.OperRouter.CreateLLM:input_type -> operrouter.v1.CreateLLMRequest
+	77, // 87: operrouter.v1.OperRouter.GenerateLLM:input_type -> operrouter.v1.GenerateLLMRequest
+	79, // 88: operrouter.v1.OperRouter.ChatLLM:input_type -> operrouter.v1.ChatLLMRequest
+	81, // 89: operrouter.v1.OperRouter.EmbeddingLLM:input_type -> operrouter.v1.EmbeddingLLMRequest
+	83, // 90: operrouter.v1.OperRouter.BatchEmbeddingLLM:input_type -> operrouter.v1.BatchEmbeddingLLMRequest
+	86, // 91: operrouter.v1.OperRouter.StreamLLM:input_type -> operrouter.v1.StreamLLMRequest
+	88, // 92: operrouter.v1.OperRouter.PingLLM:input_type -> operrouter.v1.PingLLMRequest
+	90, // 93: operrouter.v1.OperRouter.CloseLLM:input_type -> operrouter.v1.CloseLLMRequest
+	9,  // 94: operrouter.v1.OperRouter.Ping:output_type -> operrouter.v1.PingResponse
+	11, // 95: operrouter.v1.OperRouter.ValidateConfig:output_type -> operrouter.v1.ValidateConfigResponse
+	13, // 96: operrouter.v1.OperRouter.LoadConfig:output_type -> operrouter.v1.LoadConfigResponse
+	15, // 97: operrouter.v1.OperRouter.GetMetadata:output_type -> operrouter.v1.GetMetadataResponse
+	22, // 98: operrouter.v1.OperRouter.CreateDataSource:output_type -> operrouter.v1.CreateDataSourceResponse
+	24, // 99: operrouter.v1.OperRouter.QueryDataSource:output_type -> operrouter.v1.QueryDataSourceResponse
+	26, // 100: operrouter.v1.OperRouter.StreamQueryDataSource:output_type -> operrouter.v1.StreamQueryDataSourceResponse
+	28, // 101: operrouter.v1.OperRouter.ExecuteDataSource:output_type -> operrouter.v1.ExecuteDataSourceResponse
+	30, // 102: operrouter.v1.OperRouter.InsertDataSource:output_type -> operrouter.v1.InsertDataSourceResponse
+	32, // 103: operrouter.v1.OperRouter.PingDataSource:output_type -> operrouter.v1.PingDataSourceResponse
+	34, // 104: operrouter.v1.OperRouter.CloseDataSource:output_type -> operrouter.v1.CloseDataSourceResponse
+	36, // 105: operrouter.v1.OperRouter.BeginTransaction:output_type -> operrouter.v1.BeginTransactionResponse
+	38, // 106: operrouter.v1.OperRouter.CommitTransaction:output_type -> operrouter.v1.CommitTransactionResponse
+	40, // 107: operrouter.v1.OperRouter.RollbackTransaction:output_type -> operrouter.v1.RollbackTransactionResponse
+	43, // 108: operrouter.v1.OperRouter.ListTables:output_type -> operrouter.v1.ListTablesResponse
+	46, // 109: operrouter.v1.OperRouter.DescribeTable:output_type -> operrouter.v1.DescribeTableResponse
+	51, // 110: operrouter.v1.OperRouter.KafkaPublish:output_type -> operrouter.v1.KafkaPublishResponse
+	54, // 111: operrouter.v1.OperRouter.KafkaSubscribe:output_type -> operrouter.v1.KafkaSubscribeResponse
+	57, // 112: operrouter.v1.OperRouter.KafkaCommit:output_type -> operrouter.v1.KafkaCommitResponse
+	60, // 113: operrouter.v1.OperRouter.MongoFind:output_type -> operrouter.v1.MongoFindResponse
+	62, // 114: operrouter.v1.OperRouter.MongoInsert:output_type -> operrouter.v1.MongoInsertResponse
+	64, // 115: operrouter.v1.OperRouter.MongoUpdate:output_type -> operrouter.v1.MongoUpdateResponse
+	66, // 116: operrouter.v1.OperRouter.MongoDelete:output_type -> operrouter.v1.MongoDeleteResponse
+	74, // 117: operrouter.v1.OperRouter.CreateLLM:output_type -> operrouter.v1.CreateLLMResponse
+	78, // 118: operrouter.v1.OperRouter.GenerateLLM:output_type -> operrouter.v1.GenerateLLMResponse
+	80, // 119: operrouter.v1.OperRouter.ChatLLM:output_type -> operrouter.v1.ChatLLMResponse
+	82, // 120: operrouter.v1.OperRouter.EmbeddingLLM:output_type -> operrouter.v1.EmbeddingLLMResponse
+	85, // 121: operrouter.v1.OperRouter.BatchEmbeddingLLM:output_type -> operrouter.v1.BatchEmbeddingLLMResponse
+	87, // 122: operrouter.v1.OperRouter.StreamLLM:output_type -> operrouter.v1.StreamLLMResponse
+	89, // 123: operrouter.v1.OperRouter.PingLLM:output_type -> operrouter.v1.PingLLMResponse
+	91, // 124: operrouter.v1.OperRouter.CloseLLM:output_type -> operrouter.v1.CloseLLMResponse
+	94, // [94:125] is the sub-list for method output_type
+	63, // [63:94] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
+}
+
+func init() { file_proto_operrouter_proto_init() }
+func file_proto_operrouter_proto_init() {
+	if File_proto_operrouter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_operrouter_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*LoadConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*LoadConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DataSourceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateDataSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateDataSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryDataSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryDataSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamQueryDataSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamQueryDataSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteDataSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteDataSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*InsertDataSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*InsertDataSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*PingDataSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*PingDataSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseDataSourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3277,8 +7647,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*PingRequest); i {
+		file_proto_operrouter_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseDataSourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3289,8 +7659,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*PingResponse); i {
+		file_proto_operrouter_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*BeginTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3301,8 +7671,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ValidateConfigRequest); i {
+		file_proto_operrouter_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*BeginTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3313,8 +7683,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ValidateConfigResponse); i {
+		file_proto_operrouter_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*CommitTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3325,8 +7695,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*LoadConfigRequest); i {
+		file_proto_operrouter_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*CommitTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3337,8 +7707,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*LoadConfigResponse); i {
+		file_proto_operrouter_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*RollbackTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3349,8 +7719,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMetadataRequest); i {
+		file_proto_operrouter_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*RollbackTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3361,8 +7731,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMetadataResponse); i {
+		file_proto_operrouter_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*TableInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3373,8 +7743,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*DataSourceConfig); i {
+		file_proto_operrouter_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTablesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3385,8 +7755,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*Value); i {
+		file_proto_operrouter_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTablesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3397,8 +7767,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueArray); i {
+		file_proto_operrouter_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ColumnInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3409,8 +7779,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueObject); i {
+		file_proto_operrouter_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*DescribeTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3421,8 +7791,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*Row); i {
+		file_proto_operrouter_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*DescribeTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3433,8 +7803,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateDataSourceRequest); i {
+		file_proto_operrouter_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3445,8 +7815,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateDataSourceResponse); i {
+		file_proto_operrouter_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3457,8 +7827,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*QueryDataSourceRequest); i {
+		file_proto_operrouter_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaPublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3469,8 +7839,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*QueryDataSourceResponse); i {
+		file_proto_operrouter_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaDeliveryReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3481,8 +7851,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteDataSourceRequest); i {
+		file_proto_operrouter_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaPublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3493,8 +7863,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecuteDataSourceResponse); i {
+		file_proto_operrouter_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3505,8 +7875,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*InsertDataSourceRequest); i {
+		file_proto_operrouter_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3517,8 +7887,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*InsertDataSourceResponse); i {
+		file_proto_operrouter_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3529,8 +7899,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*PingDataSourceRequest); i {
+		file_proto_operrouter_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaPartitionOffset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3541,8 +7911,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*PingDataSourceResponse); i {
+		file_proto_operrouter_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaCommitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3553,8 +7923,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseDataSourceRequest); i {
+		file_proto_operrouter_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*KafkaCommitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3565,8 +7935,8 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseDataSourceResponse); i {
+		file_proto_operrouter_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*SortField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3577,7 +7947,103 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoFindRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoFindResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoInsertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoInsertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*MongoDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*LLMConfig); i {
 			case 0:
 				return &v.state
@@ -3589,7 +8055,31 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ImagePart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*ContentPart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*LLMMessage); i {
 			case 0:
 				return &v.state
@@ -3601,7 +8091,31 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ToolDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ToolCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[66].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3613,7 +8127,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[67].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3625,7 +8139,31 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ResponseFormat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerationOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[70].Exporter = func(v any, i int) any {
 			switch v := v.(*GenerateLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3637,7 +8175,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[71].Exporter = func(v any, i int) any {
 			switch v := v.(*GenerateLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3649,7 +8187,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[72].Exporter = func(v any, i int) any {
 			switch v := v.(*ChatLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3661,7 +8199,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[33].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[73].Exporter = func(v any, i int) any {
 			switch v := v.(*ChatLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3673,7 +8211,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[74].Exporter = func(v any, i int) any {
 			switch v := v.(*EmbeddingLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3685,7 +8223,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[75].Exporter = func(v any, i int) any {
 			switch v := v.(*EmbeddingLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3697,7 +8235,43 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchEmbeddingLLMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*EmbeddingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchEmbeddingLLMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_operrouter_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*StreamLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3709,7 +8283,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[80].Exporter = func(v any, i int) any {
 			switch v := v.(*StreamLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3721,7 +8295,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[38].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[81].Exporter = func(v any, i int) any {
 			switch v := v.(*PingLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3733,7 +8307,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[39].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[82].Exporter = func(v any, i int) any {
 			switch v := v.(*PingLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3745,7 +8319,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[40].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[83].Exporter = func(v any, i int) any {
 			switch v := v.(*CloseLLMRequest); i {
 			case 0:
 				return &v.state
@@ -3757,7 +8331,7 @@ func file_proto_operrouter_proto_init() {
 				return nil
 			}
 		}
-		file_proto_operrouter_proto_msgTypes[41].Exporter = func(v any, i int) any {
+		file_proto_operrouter_proto_msgTypes[84].Exporter = func(v any, i int) any {
 			switch v := v.(*CloseLLMResponse); i {
 			case 0:
 				return &v.state
@@ -3781,17 +8355,29 @@ func file_proto_operrouter_proto_init() {
 		(*Value_ArrayValue)(nil),
 		(*Value_ObjectValue)(nil),
 	}
-	file_proto_operrouter_proto_msgTypes[26].OneofWrappers = []any{}
-	file_proto_operrouter_proto_msgTypes[31].OneofWrappers = []any{}
-	file_proto_operrouter_proto_msgTypes[33].OneofWrappers = []any{}
-	file_proto_operrouter_proto_msgTypes[35].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[21].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[41].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[52].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[57].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[60].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[62].OneofWrappers = []any{
+		(*ContentPart_Text)(nil),
+		(*ContentPart_Image)(nil),
+	}
+	file_proto_operrouter_proto_msgTypes[63].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[69].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[71].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[73].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[75].OneofWrappers = []any{}
+	file_proto_operrouter_proto_msgTypes[78].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_operrouter_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   45,
+			NumEnums:      7,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,23 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OperRouter_Ping_FullMethodName              = "/operrouter.v1.OperRouter/Ping"
-	OperRouter_ValidateConfig_FullMethodName    = "/operrouter.v1.OperRouter/ValidateConfig"
-	OperRouter_LoadConfig_FullMethodName        = "/operrouter.v1.OperRouter/LoadConfig"
-	OperRouter_GetMetadata_FullMethodName       = "/operrouter.v1.OperRouter/GetMetadata"
-	OperRouter_CreateDataSource_FullMethodName  = "/operrouter.v1.OperRouter/CreateDataSource"
-	OperRouter_QueryDataSource_FullMethodName   = "/operrouter.v1.OperRouter/QueryDataSource"
-	OperRouter_ExecuteDataSource_FullMethodName = "/operrouter.v1.OperRouter/ExecuteDataSource"
-	OperRouter_InsertDataSource_FullMethodName  = "/operrouter.v1.OperRouter/InsertDataSource"
-	OperRouter_PingDataSource_FullMethodName    = "/operrouter.v1.OperRouter/PingDataSource"
-	OperRouter_CloseDataSource_FullMethodName   = "/operrouter.v1.OperRouter/CloseDataSource"
-	OperRouter_CreateLLM_FullMethodName         = "/operrouter.v1.OperRouter/CreateLLM"
-	OperRouter_GenerateLLM_FullMethodName       = "/operrouter.v1.OperRouter/GenerateLLM"
-	OperRouter_ChatLLM_FullMethodName           = "/operrouter.v1.OperRouter/ChatLLM"
-	OperRouter_EmbeddingLLM_FullMethodName      = "/operrouter.v1.OperRouter/EmbeddingLLM"
-	OperRouter_StreamLLM_FullMethodName         = "/operrouter.v1.OperRouter/StreamLLM"
-	OperRouter_PingLLM_FullMethodName           = "/operrouter.v1.OperRouter/PingLLM"
-	OperRouter_CloseLLM_FullMethodName          = "/operrouter.v1.OperRouter/CloseLLM"
+	OperRouter_Ping_FullMethodName                  = "/operrouter.v1.OperRouter/Ping"
+	OperRouter_ValidateConfig_FullMethodName        = "/operrouter.v1.OperRouter/ValidateConfig"
+	OperRouter_LoadConfig_FullMethodName            = "/operrouter.v1.OperRouter/LoadConfig"
+	OperRouter_GetMetadata_FullMethodName           = "/operrouter.v1.OperRouter/GetMetadata"
+	OperRouter_CreateDataSource_FullMethodName      = "/operrouter.v1.OperRouter/CreateDataSource"
+	OperRouter_QueryDataSource_FullMethodName       = "/operrouter.v1.OperRouter/QueryDataSource"
+	OperRouter_StreamQueryDataSource_FullMethodName = "/operrouter.v1.OperRouter/StreamQueryDataSource"
+	OperRouter_ExecuteDataSource_FullMethodName     = "/operrouter.v1.OperRouter/ExecuteDataSource"
+	OperRouter_InsertDataSource_FullMethodName      = "/operrouter.v1.OperRouter/InsertDataSource"
+	OperRouter_PingDataSource_FullMethodName        = "/operrouter.v1.OperRouter/PingDataSource"
+	OperRouter_CloseDataSource_FullMethodName       = "/operrouter.v1.OperRouter/CloseDataSource"
+	OperRouter_BeginTransaction_FullMethodName      = "/operrouter.v1.OperRouter/BeginTransaction"
+	OperRouter_CommitTransaction_FullMethodName     = "/operrouter.v1.OperRouter/CommitTransaction"
+	OperRouter_RollbackTransaction_FullMethodName   = "/operrouter.v1.OperRouter/RollbackTransaction"
+	OperRouter_ListTables_FullMethodName            = "/operrouter.v1.OperRouter/ListTables"
+	OperRouter_DescribeTable_FullMethodName         = "/operrouter.v1.OperRouter/DescribeTable"
+	OperRouter_KafkaPublish_FullMethodName          = "/operrouter.v1.OperRouter/KafkaPublish"
+	OperRouter_KafkaSubscribe_FullMethodName        = "/operrouter.v1.OperRouter/KafkaSubscribe"
+	OperRouter_KafkaCommit_FullMethodName           = "/operrouter.v1.OperRouter/KafkaCommit"
+	OperRouter_MongoFind_FullMethodName             = "/operrouter.v1.OperRouter/MongoFind"
+	OperRouter_MongoInsert_FullMethodName           = "/operrouter.v1.OperRouter/MongoInsert"
+	OperRouter_MongoUpdate_FullMethodName           = "/operrouter.v1.OperRouter/MongoUpdate"
+	OperRouter_MongoDelete_FullMethodName           = "/operrouter.v1.OperRouter/MongoDelete"
+	OperRouter_CreateLLM_FullMethodName             = "/operrouter.v1.OperRouter/CreateLLM"
+	OperRouter_GenerateLLM_FullMethodName           = "/operrouter.v1.OperRouter/GenerateLLM"
+	OperRouter_ChatLLM_FullMethodName               = "/operrouter.v1.OperRouter/ChatLLM"
+	OperRouter_EmbeddingLLM_FullMethodName          = "/operrouter.v1.OperRouter/EmbeddingLLM"
+	OperRouter_BatchEmbeddingLLM_FullMethodName     = "/operrouter.v1.OperRouter/BatchEmbeddingLLM"
+	OperRouter_StreamLLM_FullMethodName             = "/operrouter.v1.OperRouter/StreamLLM"
+	OperRouter_PingLLM_FullMethodName               = "/operrouter.v1.OperRouter/PingLLM"
+	OperRouter_CloseLLM_FullMethodName              = "/operrouter.v1.OperRouter/CloseLLM"
 )
 
 // OperRouterClient is the client API for OperRouter service.
@@ -51,15 +65,33 @@ type OperRouterClient interface {
 	// DataSource operations
 	CreateDataSource(ctx context.Context, in *CreateDataSourceRequest, opts ...grpc.CallOption) (*CreateDataSourceResponse, error)
 	QueryDataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
+	StreamQueryDataSource(ctx context.Context, in *StreamQueryDataSourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamQueryDataSourceResponse], error)
 	ExecuteDataSource(ctx context.Context, in *ExecuteDataSourceRequest, opts ...grpc.CallOption) (*ExecuteDataSourceResponse, error)
 	InsertDataSource(ctx context.Context, in *InsertDataSourceRequest, opts ...grpc.CallOption) (*InsertDataSourceResponse, error)
 	PingDataSource(ctx context.Context, in *PingDataSourceRequest, opts ...grpc.CallOption) (*PingDataSourceResponse, error)
 	CloseDataSource(ctx context.Context, in *CloseDataSourceRequest, opts ...grpc.CallOption) (*CloseDataSourceResponse, error)
+	// DataSource transactions
+	BeginTransaction(ctx context.Context, in *BeginTransactionRequest, opts ...grpc.CallOption) (*BeginTransactionResponse, error)
+	CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*CommitTransactionResponse, error)
+	RollbackTransaction(ctx context.Context, in *RollbackTransactionRequest, opts ...grpc.CallOption) (*RollbackTransactionResponse, error)
+	// DataSource introspection
+	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
+	DescribeTable(ctx context.Context, in *DescribeTableRequest, opts ...grpc.CallOption) (*DescribeTableResponse, error)
+	// Kafka operations
+	KafkaPublish(ctx context.Context, in *KafkaPublishRequest, opts ...grpc.CallOption) (*KafkaPublishResponse, error)
+	KafkaSubscribe(ctx context.Context, in *KafkaSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KafkaSubscribeResponse], error)
+	KafkaCommit(ctx context.Context, in *KafkaCommitRequest, opts ...grpc.CallOption) (*KafkaCommitResponse, error)
+	// MongoDB operations
+	MongoFind(ctx context.Context, in *MongoFindRequest, opts ...grpc.CallOption) (*MongoFindResponse, error)
+	MongoInsert(ctx context.Context, in *MongoInsertRequest, opts ...grpc.CallOption) (*MongoInsertResponse, error)
+	MongoUpdate(ctx context.Context, in *MongoUpdateRequest, opts ...grpc.CallOption) (*MongoUpdateResponse, error)
+	MongoDelete(ctx context.Context, in *MongoDeleteRequest, opts ...grpc.CallOption) (*MongoDeleteResponse, error)
 	// LLM operations
 	CreateLLM(ctx context.Context, in *CreateLLMRequest, opts ...grpc.CallOption) (*CreateLLMResponse, error)
 	GenerateLLM(ctx context.Context, in *GenerateLLMRequest, opts ...grpc.CallOption) (*GenerateLLMResponse, error)
 	ChatLLM(ctx context.Context, in *ChatLLMRequest, opts ...grpc.CallOption) (*ChatLLMResponse, error)
 	EmbeddingLLM(ctx context.Context, in *EmbeddingLLMRequest, opts ...grpc.CallOption) (*EmbeddingLLMResponse, error)
+	BatchEmbeddingLLM(ctx context.Context, in *BatchEmbeddingLLMRequest, opts ...grpc.CallOption) (*BatchEmbeddingLLMResponse, error)
 	StreamLLM(ctx context.Context, in *StreamLLMRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamLLMResponse], error)
 	PingLLM(ctx context.Context, in *PingLLMRequest, opts ...grpc.CallOption) (*PingLLMResponse, error)
 	CloseLLM(ctx context.Context, in *CloseLLMRequest, opts ...grpc.CallOption) (*CloseLLMResponse, error)
@@ -133,6 +165,25 @@ func (c *operRouterClient) QueryDataSource(ctx context.Context, in *QueryDataSou
 	return out, nil
 }
 
+func (c *operRouterClient) StreamQueryDataSource(ctx context.Context, in *StreamQueryDataSourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamQueryDataSourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OperRouter_ServiceDesc.Streams[0], OperRouter_StreamQueryDataSource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamQueryDataSourceRequest, StreamQueryDataSourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OperRouter_StreamQueryDataSourceClient = grpc.ServerStreamingClient[StreamQueryDataSourceResponse]
+
 func (c *operRouterClient) ExecuteDataSource(ctx context.Context, in *ExecuteDataSourceRequest, opts ...grpc.CallOption) (*ExecuteDataSourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteDataSourceResponse)
@@ -173,6 +224,135 @@ func (c *operRouterClient) CloseDataSource(ctx context.Context, in *CloseDataSou
 	return out, nil
 }
 
+func (c *operRouterClient) BeginTransaction(ctx context.Context, in *BeginTransactionRequest, opts ...grpc.CallOption) (*BeginTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTransactionResponse)
+	err := c.cc.Invoke(ctx, OperRouter_BeginTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*CommitTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitTransactionResponse)
+	err := c.cc.Invoke(ctx, OperRouter_CommitTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) RollbackTransaction(ctx context.Context, in *RollbackTransactionRequest, opts ...grpc.CallOption) (*RollbackTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackTransactionResponse)
+	err := c.cc.Invoke(ctx, OperRouter_RollbackTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTablesResponse)
+	err := c.cc.Invoke(ctx, OperRouter_ListTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) DescribeTable(ctx context.Context, in *DescribeTableRequest, opts ...grpc.CallOption) (*DescribeTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeTableResponse)
+	err := c.cc.Invoke(ctx, OperRouter_DescribeTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) KafkaPublish(ctx context.Context, in *KafkaPublishRequest, opts ...grpc.CallOption) (*KafkaPublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KafkaPublishResponse)
+	err := c.cc.Invoke(ctx, OperRouter_KafkaPublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) KafkaSubscribe(ctx context.Context, in *KafkaSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KafkaSubscribeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OperRouter_ServiceDesc.Streams[1], OperRouter_KafkaSubscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[KafkaSubscribeRequest, KafkaSubscribeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OperRouter_KafkaSubscribeClient = grpc.ServerStreamingClient[KafkaSubscribeResponse]
+
+func (c *operRouterClient) KafkaCommit(ctx context.Context, in *KafkaCommitRequest, opts ...grpc.CallOption) (*KafkaCommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KafkaCommitResponse)
+	err := c.cc.Invoke(ctx, OperRouter_KafkaCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) MongoFind(ctx context.Context, in *MongoFindRequest, opts ...grpc.CallOption) (*MongoFindResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MongoFindResponse)
+	err := c.cc.Invoke(ctx, OperRouter_MongoFind_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) MongoInsert(ctx context.Context, in *MongoInsertRequest, opts ...grpc.CallOption) (*MongoInsertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MongoInsertResponse)
+	err := c.cc.Invoke(ctx, OperRouter_MongoInsert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) MongoUpdate(ctx context.Context, in *MongoUpdateRequest, opts ...grpc.CallOption) (*MongoUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MongoUpdateResponse)
+	err := c.cc.Invoke(ctx, OperRouter_MongoUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operRouterClient) MongoDelete(ctx context.Context, in *MongoDeleteRequest, opts ...grpc.CallOption) (*MongoDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MongoDeleteResponse)
+	err := c.cc.Invoke(ctx, OperRouter_MongoDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operRouterClient) CreateLLM(ctx context.Context, in *CreateLLMRequest, opts ...grpc.CallOption) (*CreateLLMResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLLMResponse)
@@ -213,9 +393,19 @@ func (c *operRouterClient) EmbeddingLLM(ctx context.Context, in *EmbeddingLLMReq
 	return out, nil
 }
 
+func (c *operRouterClient) BatchEmbeddingLLM(ctx context.Context, in *BatchEmbeddingLLMRequest, opts ...grpc.CallOption) (*BatchEmbeddingLLMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchEmbeddingLLMResponse)
+	err := c.cc.Invoke(ctx, OperRouter_BatchEmbeddingLLM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operRouterClient) StreamLLM(ctx context.Context, in *StreamLLMRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamLLMResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &OperRouter_ServiceDesc.Streams[0], OperRouter_StreamLLM_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &OperRouter_ServiceDesc.Streams[2], OperRouter_StreamLLM_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,15 +455,33 @@ type OperRouterServer interface {
 	// DataSource operations
 	CreateDataSource(context.Context, *CreateDataSourceRequest) (*CreateDataSourceResponse, error)
 	QueryDataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
+	StreamQueryDataSource(*StreamQueryDataSourceRequest, grpc.ServerStreamingServer[StreamQueryDataSourceResponse]) error
 	ExecuteDataSource(context.Context, *ExecuteDataSourceRequest) (*ExecuteDataSourceResponse, error)
 	InsertDataSource(context.Context, *InsertDataSourceRequest) (*InsertDataSourceResponse, error)
 	PingDataSource(context.Context, *PingDataSourceRequest) (*PingDataSourceResponse, error)
 	CloseDataSource(context.Context, *CloseDataSourceRequest) (*CloseDataSourceResponse, error)
+	// DataSource transactions
+	BeginTransaction(context.Context, *BeginTransactionRequest) (*BeginTransactionResponse, error)
+	CommitTransaction(context.Context, *CommitTransactionRequest) (*CommitTransactionResponse, error)
+	RollbackTransaction(context.Context, *RollbackTransactionRequest) (*RollbackTransactionResponse, error)
+	// DataSource introspection
+	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
+	DescribeTable(context.Context, *DescribeTableRequest) (*DescribeTableResponse, error)
+	// Kafka operations
+	KafkaPublish(context.Context, *KafkaPublishRequest) (*KafkaPublishResponse, error)
+	KafkaSubscribe(*KafkaSubscribeRequest, grpc.ServerStreamingServer[KafkaSubscribeResponse]) error
+	KafkaCommit(context.Context, *KafkaCommitRequest) (*KafkaCommitResponse, error)
+	// MongoDB operations
+	MongoFind(context.Context, *MongoFindRequest) (*MongoFindResponse, error)
+	MongoInsert(context.Context, *MongoInsertRequest) (*MongoInsertResponse, error)
+	MongoUpdate(context.Context, *MongoUpdateRequest) (*MongoUpdateResponse, error)
+	MongoDelete(context.Context, *MongoDeleteRequest) (*MongoDeleteResponse, error)
 	// LLM operations
 	CreateLLM(context.Context, *CreateLLMRequest) (*CreateLLMResponse, error)
 	GenerateLLM(context.Context, *GenerateLLMRequest) (*GenerateLLMResponse, error)
 	ChatLLM(context.Context, *ChatLLMRequest) (*ChatLLMResponse, error)
 	EmbeddingLLM(context.Context, *EmbeddingLLMRequest) (*EmbeddingLLMResponse, error)
+	BatchEmbeddingLLM(context.Context, *BatchEmbeddingLLMRequest) (*BatchEmbeddingLLMResponse, error)
 	StreamLLM(*StreamLLMRequest, grpc.ServerStreamingServer[StreamLLMResponse]) error
 	PingLLM(context.Context, *PingLLMRequest) (*PingLLMResponse, error)
 	CloseLLM(context.Context, *CloseLLMRequest) (*CloseLLMResponse, error)
@@ -305,6 +513,9 @@ func (UnimplementedOperRouterServer) CreateDataSource(context.Context, *CreateDa
 func (UnimplementedOperRouterServer) QueryDataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDataSource not implemented")
 }
+func (UnimplementedOperRouterServer) StreamQueryDataSource(*StreamQueryDataSourceRequest, grpc.ServerStreamingServer[StreamQueryDataSourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQueryDataSource not implemented")
+}
 func (UnimplementedOperRouterServer) ExecuteDataSource(context.Context, *ExecuteDataSourceRequest) (*ExecuteDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteDataSource not implemented")
 }
@@ -317,6 +528,42 @@ func (UnimplementedOperRouterServer) PingDataSource(context.Context, *PingDataSo
 func (UnimplementedOperRouterServer) CloseDataSource(context.Context, *CloseDataSourceRequest) (*CloseDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseDataSource not implemented")
 }
+func (UnimplementedOperRouterServer) BeginTransaction(context.Context, *BeginTransactionRequest) (*BeginTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTransaction not implemented")
+}
+func (UnimplementedOperRouterServer) CommitTransaction(context.Context, *CommitTransactionRequest) (*CommitTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTransaction not implemented")
+}
+func (UnimplementedOperRouterServer) RollbackTransaction(context.Context, *RollbackTransactionRequest) (*RollbackTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTransaction not implemented")
+}
+func (UnimplementedOperRouterServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedOperRouterServer) DescribeTable(context.Context, *DescribeTableRequest) (*DescribeTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTable not implemented")
+}
+func (UnimplementedOperRouterServer) KafkaPublish(context.Context, *KafkaPublishRequest) (*KafkaPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KafkaPublish not implemented")
+}
+func (UnimplementedOperRouterServer) KafkaSubscribe(*KafkaSubscribeRequest, grpc.ServerStreamingServer[KafkaSubscribeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method KafkaSubscribe not implemented")
+}
+func (UnimplementedOperRouterServer) KafkaCommit(context.Context, *KafkaCommitRequest) (*KafkaCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KafkaCommit not implemented")
+}
+func (UnimplementedOperRouterServer) MongoFind(context.Context, *MongoFindRequest) (*MongoFindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MongoFind not implemented")
+}
+func (UnimplementedOperRouterServer) MongoInsert(context.Context, *MongoInsertRequest) (*MongoInsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MongoInsert not implemented")
+}
+func (UnimplementedOperRouterServer) MongoUpdate(context.Context, *MongoUpdateRequest) (*MongoUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MongoUpdate not implemented")
+}
+func (UnimplementedOperRouterServer) MongoDelete(context.Context, *MongoDeleteRequest) (*MongoDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MongoDelete not implemented")
+}
 func (UnimplementedOperRouterServer) CreateLLM(context.Context, *CreateLLMRequest) (*CreateLLMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLLM not implemented")
 }
@@ -329,6 +576,9 @@ func (UnimplementedOperRouterServer) ChatLLM(context.Context, *ChatLLMRequest) (
 func (UnimplementedOperRouterServer) EmbeddingLLM(context.Context, *EmbeddingLLMRequest) (*EmbeddingLLMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmbeddingLLM not implemented")
 }
+func (UnimplementedOperRouterServer) BatchEmbeddingLLM(context.Context, *BatchEmbeddingLLMRequest) (*BatchEmbeddingLLMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEmbeddingLLM not implemented")
+}
 func (UnimplementedOperRouterServer) StreamLLM(*StreamLLMRequest, grpc.ServerStreamingServer[StreamLLMResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamLLM not implemented")
 }
@@ -467,6 +717,17 @@ func _OperRouter_QueryDataSource_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperRouter_StreamQueryDataSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamQueryDataSourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperRouterServer).StreamQueryDataSource(m, &grpc.GenericServerStream[StreamQueryDataSourceRequest, StreamQueryDataSourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OperRouter_StreamQueryDataSourceServer = grpc.ServerStreamingServer[StreamQueryDataSourceResponse]
+
 func _OperRouter_ExecuteDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteDataSourceRequest)
 	if err := dec(in); err != nil {
@@ -539,6 +800,215 @@ func _OperRouter_CloseDataSource_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperRouter_BeginTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).BeginTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_BeginTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).BeginTransaction(ctx, req.(*BeginTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_CommitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).CommitTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_CommitTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).CommitTransaction(ctx, req.(*CommitTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_RollbackTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).RollbackTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_RollbackTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).RollbackTransaction(ctx, req.(*RollbackTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_ListTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_DescribeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).DescribeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_DescribeTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).DescribeTable(ctx, req.(*DescribeTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_KafkaPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KafkaPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).KafkaPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_KafkaPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).KafkaPublish(ctx, req.(*KafkaPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_KafkaSubscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KafkaSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperRouterServer).KafkaSubscribe(m, &grpc.GenericServerStream[KafkaSubscribeRequest, KafkaSubscribeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OperRouter_KafkaSubscribeServer = grpc.ServerStreamingServer[KafkaSubscribeResponse]
+
+func _OperRouter_KafkaCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KafkaCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).KafkaCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_KafkaCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).KafkaCommit(ctx, req.(*KafkaCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_MongoFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MongoFindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).MongoFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_MongoFind_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).MongoFind(ctx, req.(*MongoFindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_MongoInsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MongoInsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).MongoInsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_MongoInsert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).MongoInsert(ctx, req.(*MongoInsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_MongoUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MongoUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).MongoUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_MongoUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).MongoUpdate(ctx, req.(*MongoUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperRouter_MongoDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MongoDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).MongoDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_MongoDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).MongoDelete(ctx, req.(*MongoDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OperRouter_CreateLLM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLLMRequest)
 	if err := dec(in); err != nil {
@@ -611,6 +1081,24 @@ func _OperRouter_EmbeddingLLM_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperRouter_BatchEmbeddingLLM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEmbeddingLLMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperRouterServer).BatchEmbeddingLLM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperRouter_BatchEmbeddingLLM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperRouterServer).BatchEmbeddingLLM(ctx, req.(*BatchEmbeddingLLMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OperRouter_StreamLLM_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamLLMRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -705,6 +1193,50 @@ var OperRouter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseDataSource",
 			Handler:    _OperRouter_CloseDataSource_Handler,
 		},
+		{
+			MethodName: "BeginTransaction",
+			Handler:    _OperRouter_BeginTransaction_Handler,
+		},
+		{
+			MethodName: "CommitTransaction",
+			Handler:    _OperRouter_CommitTransaction_Handler,
+		},
+		{
+			MethodName: "RollbackTransaction",
+			Handler:    _OperRouter_RollbackTransaction_Handler,
+		},
+		{
+			MethodName: "ListTables",
+			Handler:    _OperRouter_ListTables_Handler,
+		},
+		{
+			MethodName: "DescribeTable",
+			Handler:    _OperRouter_DescribeTable_Handler,
+		},
+		{
+			MethodName: "KafkaPublish",
+			Handler:    _OperRouter_KafkaPublish_Handler,
+		},
+		{
+			MethodName: "KafkaCommit",
+			Handler:    _OperRouter_KafkaCommit_Handler,
+		},
+		{
+			MethodName: "MongoFind",
+			Handler:    _OperRouter_MongoFind_Handler,
+		},
+		{
+			MethodName: "MongoInsert",
+			Handler:    _OperRouter_MongoInsert_Handler,
+		},
+		{
+			MethodName: "MongoUpdate",
+			Handler:    _OperRouter_MongoUpdate_Handler,
+		},
+		{
+			MethodName: "MongoDelete",
+			Handler:    _OperRouter_MongoDelete_Handler,
+		},
 		{
 			MethodName: "CreateLLM",
 			Handler:    _OperRouter_CreateLLM_Handler,
@@ -721,6 +1253,10 @@ var OperRouter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EmbeddingLLM",
 			Handler:    _OperRouter_EmbeddingLLM_Handler,
 		},
+		{
+			MethodName: "BatchEmbeddingLLM",
+			Handler:    _OperRouter_BatchEmbeddingLLM_Handler,
+		},
 		{
 			MethodName: "PingLLM",
 			Handler:    _OperRouter_PingLLM_Handler,
@@ -731,6 +1267,16 @@ var OperRouter_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQueryDataSource",
+			Handler:       _OperRouter_StreamQueryDataSource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "KafkaSubscribe",
+			Handler:       _OperRouter_KafkaSubscribe_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamLLM",
 			Handler:       _OperRouter_StreamLLM_Handler,
@@ -0,0 +1,87 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+// locker takes a database-wide advisory lock inside tx. The lock must be
+// released when tx ends, or by unlock.
+type locker interface {
+	lock(ctx context.Context, tx *operrouter.Tx, key string) error
+	unlock(ctx context.Context, tx *operrouter.Tx, key string) error
+}
+
+// lockerFor returns the advisory lock of a driver, or nil if it has none
+func lockerFor(driver string) locker {
+	switch strings.ToLower(driver) {
+	case "postgres", "postgresql":
+		return postgresLocker{}
+	case "mysql":
+		return mysqlLocker{}
+	default:
+		return nil
+	}
+}
+
+// postgresLocker uses a transaction-level advisory lock, released when the
+// transaction ends
+type postgresLocker struct{}
+
+func (postgresLocker) lock(ctx context.Context, tx *operrouter.Tx, key string) error {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	resp, err := tx.Query(ctx, "SELECT pg_advisory_xact_lock($1)", int64(h.Sum64()))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+	return nil
+}
+
+func (postgresLocker) unlock(context.Context, *operrouter.Tx, string) error {
+	return nil
+}
+
+// mysqlLocker uses a named lock. Named locks belong to the session, not the
+// transaction, so they are released explicitly.
+type mysqlLocker struct{}
+
+func (mysqlLocker) lock(ctx context.Context, tx *operrouter.Tx, key string) error {
+	// A negative timeout waits until the lock is free
+	resp, err := tx.Query(ctx, "SELECT GET_LOCK(?, -1) AS locked", mysqlLockName(key))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+	if len(resp.Rows) != 1 || resp.Rows[0]["locked"] != int64(1) {
+		return fmt.Errorf("GET_LOCK did not grant the lock")
+	}
+	return nil
+}
+
+func (mysqlLocker) unlock(ctx context.Context, tx *operrouter.Tx, key string) error {
+	resp, err := tx.Query(ctx, "SELECT RELEASE_LOCK(?)", mysqlLockName(key))
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+	return nil
+}
+
+// mysqlLockName fits key into the 64 characters MySQL allows for lock names
+func mysqlLockName(key string) string {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return fmt.Sprintf("operrouter_migrate_%016x", h.Sum64())
+}
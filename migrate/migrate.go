@@ -0,0 +1,534 @@
+// Package migrate applies versioned SQL migrations to an OperRouter
+// DataSource.
+//
+// Migrations are read from the root of an fs.FS, one pair of files per version:
+//
+//	0001_create_users.up.sql
+//	0001_create_users.down.sql
+//	0002_add_email.up.sql
+//
+// Down files are optional. Each file is sent to ExecuteDataSource as a single
+// statement batch. Applied versions are recorded in a table
+// (schema_migrations by default), and an advisory lock keeps concurrent runs
+// from applying the same migration twice.
+//
+// On PostgreSQL each migration and its bookkeeping row run inside one
+// transaction. MySQL commits DDL implicitly, so there a migration is not
+// atomic: its version is recorded as dirty before the script runs and marked
+// clean afterwards. A failed script leaves the version dirty, and every later
+// run fails with a *DirtyError until the schema is repaired by hand and the
+// version settled with Force.
+//
+//	//go:embed migrations/*.sql
+//	var migrations embed.FS
+//
+//	sub, _ := fs.Sub(migrations, "migrations")
+//	m, err := migrate.New(client, "my_db", sub)
+//	steps, err := m.Up(ctx)
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+// DefaultTable is the bookkeeping table used unless WithTable is given
+const DefaultTable = "schema_migrations"
+
+// Direction tells whether a step applies or reverts a migration
+type Direction int
+
+const (
+	DirectionUp Direction = iota
+	DirectionDown
+)
+
+// String returns "up" or "down"
+func (d Direction) String() string {
+	if d == DirectionDown {
+		return "down"
+	}
+	return "up"
+}
+
+// Step is one migration applied or reverted by a run
+type Step struct {
+	Version   uint64
+	Name      string
+	Direction Direction
+	// SQL is the up or down script that was, or in a dry run would be, executed
+	SQL string
+}
+
+// DirtyError reports a migration that failed part way on a DataSource without
+// transactional DDL, leaving the schema in an unknown state
+type DirtyError struct {
+	Version uint64
+}
+
+func (e *DirtyError) Error() string {
+	return fmt.Sprintf("migrate: version %d is dirty: a previous run failed part way; repair the schema and call Force", e.Version)
+}
+
+// Option configures a Migrator
+type Option func(*options)
+
+// options holds the settings collected from Option values
+type options struct {
+	table  string
+	driver string
+	noLock bool
+	dryRun bool
+	logger *slog.Logger
+}
+
+// WithTable sets the bookkeeping table, optionally schema-qualified
+func WithTable(table string) Option {
+	return func(o *options) {
+		o.table = table
+	}
+}
+
+// WithDriver sets the SQL dialect of the DataSource: "postgres" (the default)
+// or "mysql". It selects the placeholder style and the advisory lock.
+func WithDriver(driver string) Option {
+	return func(o *options) {
+		o.driver = driver
+	}
+}
+
+// WithoutLock skips the advisory lock, for DataSources that have none.
+// Concurrent runs must then be prevented by other means.
+func WithoutLock() Option {
+	return func(o *options) {
+		o.noLock = true
+	}
+}
+
+// WithDryRun makes Up, Down and To report the steps they would run without
+// executing them or taking the lock
+func WithDryRun() Option {
+	return func(o *options) {
+		o.dryRun = true
+	}
+}
+
+// WithLogger sets the logger that reports each step
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// identifier matches a plain or schema-qualified table name
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// Migrator applies the migrations of one fs.FS to one DataSource
+type Migrator struct {
+	client     operrouter.Client
+	datasource string
+	migrations []*Migration
+	opts       options
+	locker     locker
+}
+
+// New reads the migrations at the root of fsys
+func New(client operrouter.Client, datasource string, fsys fs.FS, opts ...Option) (*Migrator, error) {
+	o := options{
+		table:  DefaultTable,
+		driver: "postgres",
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.logger == nil {
+		o.logger = slog.New(slog.DiscardHandler)
+	}
+	if !identifier.MatchString(o.table) {
+		return nil, fmt.Errorf("migrate: invalid table name %q", o.table)
+	}
+
+	m := &Migrator{client: client, datasource: datasource, opts: o}
+	if !o.noLock {
+		if m.locker = lockerFor(o.driver); m.locker == nil {
+			return nil, fmt.Errorf("migrate: no advisory lock for driver %q; use WithoutLock", o.driver)
+		}
+	}
+
+	migrations, err := readMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	m.migrations = migrations
+	return m, nil
+}
+
+// Migrations returns every migration found, ordered by version
+func (m *Migrator) Migrations() []Migration {
+	migrations := make([]Migration, len(m.migrations))
+	for i, mig := range m.migrations {
+		migrations[i] = *mig
+	}
+	return migrations
+}
+
+// Version returns the highest applied version, or 0 when none is applied
+func (m *Migrator) Version(ctx context.Context) (uint64, error) {
+	applied, err := m.appliedIfExists(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var version uint64
+	for v := range applied {
+		version = max(version, v)
+	}
+	return version, nil
+}
+
+// Up applies every pending migration in version order. It returns the steps
+// run, which stop at the first failure.
+func (m *Migrator) Up(ctx context.Context) ([]Step, error) {
+	return m.run(ctx, func(applied map[uint64]bool) ([]Step, error) {
+		var steps []Step
+		for _, mig := range m.migrations {
+			if !applied[mig.Version] {
+				steps = append(steps, upStep(mig))
+			}
+		}
+		return steps, nil
+	})
+}
+
+// Down reverts the most recently applied migration
+func (m *Migrator) Down(ctx context.Context) ([]Step, error) {
+	return m.run(ctx, func(applied map[uint64]bool) ([]Step, error) {
+		var latest uint64
+		for v := range applied {
+			latest = max(latest, v)
+		}
+		if latest == 0 {
+			return nil, nil
+		}
+		step, err := m.downStep(latest)
+		if err != nil {
+			return nil, err
+		}
+		return []Step{step}, nil
+	})
+}
+
+// To migrates to version: it reverts applied migrations above it, newest
+// first, then applies pending migrations up to it. Version 0 reverts everything.
+func (m *Migrator) To(ctx context.Context, version uint64) ([]Step, error) {
+	if version != 0 && m.find(version) == nil {
+		return nil, fmt.Errorf("migrate: no migration with version %d", version)
+	}
+	return m.run(ctx, func(applied map[uint64]bool) ([]Step, error) {
+		var steps []Step
+		for v := range applied {
+			if v > version {
+				step, err := m.downStep(v)
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, step)
+			}
+		}
+		// Revert newest first
+		sort.Slice(steps, func(i, j int) bool {
+			return steps[i].Version > steps[j].Version
+		})
+
+		for _, mig := range m.migrations {
+			if mig.Version <= version && !applied[mig.Version] {
+				steps = append(steps, upStep(mig))
+			}
+		}
+		return steps, nil
+	})
+}
+
+// run plans the steps from the applied versions and executes them under the lock
+func (m *Migrator) run(ctx context.Context, plan func(applied map[uint64]bool) ([]Step, error)) ([]Step, error) {
+	if m.opts.dryRun {
+		applied, err := m.appliedIfExists(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return plan(applied)
+	}
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := m.createTable(ctx); err != nil {
+		return nil, err
+	}
+	// Read the versions only once the lock is held, so a run that waited
+	// sees what the previous one applied
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := plan(applied)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, step := range steps {
+		if err := m.apply(ctx, step); err != nil {
+			return steps[:i], fmt.Errorf("migrate: %s %d_%s: %w", step.Direction, step.Version, step.Name, err)
+		}
+		m.opts.logger.InfoContext(ctx, "migration applied",
+			"datasource", m.datasource, "version", step.Version, "name", step.Name, "direction", step.Direction.String())
+	}
+	return steps, nil
+}
+
+// Force settles a version left dirty by a failed run, once the schema has
+// been repaired by hand: with applied set the version is recorded as applied,
+// otherwise its record is removed. Force does not run any migration script.
+func (m *Migrator) Force(ctx context.Context, version uint64, applied bool) error {
+	mig := m.find(version)
+	if applied && mig == nil {
+		return fmt.Errorf("migrate: no migration with version %d", version)
+	}
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := m.createTable(ctx); err != nil {
+		return err
+	}
+	if err := m.execute(ctx, m.deleteRecord(), version); err != nil {
+		return fmt.Errorf("migrate: force %d: %w", version, err)
+	}
+	if applied {
+		if err := m.execute(ctx, m.insertRecord(false), version, mig.Name); err != nil {
+			return fmt.Errorf("migrate: force %d: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// createTable creates the bookkeeping table if it does not exist
+func (m *Migrator) createTable(ctx context.Context) error {
+	if err := m.execute(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (version BIGINT PRIMARY KEY, name VARCHAR(255) NOT NULL, dirty BOOLEAN NOT NULL DEFAULT FALSE, applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)",
+		m.opts.table)); err != nil {
+		return fmt.Errorf("migrate: create %s: %w", m.opts.table, err)
+	}
+	return nil
+}
+
+// lock takes the advisory lock in a transaction held open for the whole run
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	if m.locker == nil {
+		return func() {}, nil
+	}
+
+	tx, err := m.client.BeginTx(ctx, m.datasource, nil)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: lock: %w", err)
+	}
+	key := m.datasource + "/" + m.opts.table
+	if err := m.locker.lock(ctx, tx, key); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("migrate: lock: %w", err)
+	}
+
+	return func() {
+		ctx := context.WithoutCancel(ctx)
+		if err := m.locker.unlock(ctx, tx, key); err != nil {
+			m.opts.logger.WarnContext(ctx, "migrate: unlock failed", "datasource", m.datasource, "error", err)
+		}
+		tx.Rollback()
+	}, nil
+}
+
+// transactionalDDL reports whether schema changes can be rolled back, which
+// MySQL cannot do
+func (m *Migrator) transactionalDDL() bool {
+	return !strings.EqualFold(m.opts.driver, "mysql")
+}
+
+// apply runs one step and updates the bookkeeping table
+func (m *Migrator) apply(ctx context.Context, step Step) error {
+	if !m.transactionalDDL() {
+		return m.applyDirty(ctx, step)
+	}
+
+	tx, err := m.client.BeginTx(ctx, m.datasource, nil)
+	if err != nil {
+		return err
+	}
+
+	record, args := m.deleteRecord(), []interface{}{step.Version}
+	if step.Direction == DirectionUp {
+		record, args = m.insertRecord(false), []interface{}{step.Version, step.Name}
+	}
+
+	for _, stmt := range []struct {
+		query string
+		args  []interface{}
+	}{{step.SQL, nil}, {record, args}} {
+		resp, err := tx.Execute(ctx, stmt.query, stmt.args...)
+		if err == nil && !resp.Success {
+			err = fmt.Errorf("%s", resp.Message)
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// applyDirty runs one step without a transaction: the version is marked
+// dirty before the script runs and settled after it succeeds, so a failure
+// part way is detected by the next run instead of being retried
+func (m *Migrator) applyDirty(ctx context.Context, step Step) error {
+	markDirty, markArgs := m.insertRecord(true), []interface{}{step.Version, step.Name}
+	settle := m.deleteRecord()
+	if step.Direction == DirectionUp {
+		settle = fmt.Sprintf("UPDATE %s SET dirty = FALSE WHERE version = %s",
+			m.opts.table, operrouter.Placeholder(m.opts.driver, 1))
+	} else {
+		markDirty = fmt.Sprintf("UPDATE %s SET dirty = TRUE WHERE version = %s",
+			m.opts.table, operrouter.Placeholder(m.opts.driver, 1))
+		markArgs = []interface{}{step.Version}
+	}
+
+	if err := m.execute(ctx, markDirty, markArgs...); err != nil {
+		return err
+	}
+	if err := m.execute(ctx, step.SQL); err != nil {
+		return errors.Join(err, &DirtyError{Version: step.Version})
+	}
+	if err := m.execute(ctx, settle, step.Version); err != nil {
+		return errors.Join(err, &DirtyError{Version: step.Version})
+	}
+	return nil
+}
+
+// insertRecord returns the statement recording an applied version
+func (m *Migrator) insertRecord(dirty bool) string {
+	return fmt.Sprintf("INSERT INTO %s (version, name, dirty) VALUES (%s, %s, %t)",
+		m.opts.table, operrouter.Placeholder(m.opts.driver, 1), operrouter.Placeholder(m.opts.driver, 2), dirty)
+}
+
+// deleteRecord returns the statement removing a version's record
+func (m *Migrator) deleteRecord() string {
+	return fmt.Sprintf("DELETE FROM %s WHERE version = %s",
+		m.opts.table, operrouter.Placeholder(m.opts.driver, 1))
+}
+
+// applied returns the applied versions; it fails with a *DirtyError when a
+// version was left dirty by a failed run
+func (m *Migrator) applied(ctx context.Context) (map[uint64]bool, error) {
+	type record struct {
+		Version uint64 `db:"version"`
+		Dirty   bool   `db:"dirty"`
+	}
+	records, err := operrouter.QueryInto[record](ctx, m.client, m.datasource,
+		fmt.Sprintf("SELECT version, dirty FROM %s", m.opts.table))
+	if err != nil {
+		return nil, fmt.Errorf("migrate: read %s: %w", m.opts.table, err)
+	}
+	applied := make(map[uint64]bool, len(records))
+	for _, r := range records {
+		if r.Dirty {
+			return nil, &DirtyError{Version: r.Version}
+		}
+		applied[r.Version] = true
+	}
+	return applied, nil
+}
+
+// appliedIfExists returns the applied versions without creating the
+// bookkeeping table; a missing table means none is applied
+func (m *Migrator) appliedIfExists(ctx context.Context) (map[uint64]bool, error) {
+	exists, err := m.tableExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return make(map[uint64]bool), nil
+	}
+	return m.applied(ctx)
+}
+
+// tableExists looks the bookkeeping table up with ListTables
+func (m *Migrator) tableExists(ctx context.Context) (bool, error) {
+	schema, table := "", m.opts.table
+	if i := strings.IndexByte(table, '.'); i >= 0 {
+		schema, table = table[:i], table[i+1:]
+	}
+
+	resp, err := m.client.ListTables(ctx, m.datasource, schema)
+	if err != nil {
+		return false, fmt.Errorf("migrate: list tables: %w", err)
+	}
+	if !resp.Success {
+		return false, fmt.Errorf("migrate: list tables: %s", resp.Message)
+	}
+	for _, t := range resp.Tables {
+		if strings.EqualFold(t.Name, table) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// execute runs a statement outside any transaction
+func (m *Migrator) execute(ctx context.Context, query string, args ...interface{}) error {
+	resp, err := m.client.ExecuteDataSource(ctx, m.datasource, query, args...)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+	return nil
+}
+
+// find returns the migration with version, or nil
+func (m *Migrator) find(version uint64) *Migration {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig
+		}
+	}
+	return nil
+}
+
+// downStep reverts an applied version; it fails when the version has no down file
+func (m *Migrator) downStep(version uint64) (Step, error) {
+	mig := m.find(version)
+	if mig == nil {
+		return Step{}, fmt.Errorf("migrate: version %d is applied but has no migration files", version)
+	}
+	if mig.Down == "" {
+		return Step{}, fmt.Errorf("migrate: migration %d_%s has no down file", mig.Version, mig.Name)
+	}
+	return Step{Version: mig.Version, Name: mig.Name, Direction: DirectionDown, SQL: mig.Down}, nil
+}
+
+// upStep applies mig
+func upStep(mig *Migration) Step {
+	return Step{Version: mig.Version, Name: mig.Name, Direction: DirectionUp, SQL: mig.Up}
+}
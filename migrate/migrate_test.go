@@ -0,0 +1,245 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+// fakeDB is a DataSource holding only the bookkeeping table. Statements
+// other than the bookkeeping ones are taken to be migration scripts and
+// recorded in order; the scripts listed in fail are rejected.
+type fakeDB struct {
+	operrouter.Client
+	table    bool
+	versions map[uint64]bool // version -> dirty
+	scripts  []string
+	fail     map[string]bool
+}
+
+func newFakeDB(applied ...uint64) *fakeDB {
+	db := &fakeDB{versions: make(map[uint64]bool), fail: make(map[string]bool)}
+	for _, v := range applied {
+		db.table = true
+		db.versions[v] = false
+	}
+	return db
+}
+
+func (db *fakeDB) ListTables(ctx context.Context, name string, schema string) (*operrouter.ListTablesResponse, error) {
+	resp := &operrouter.ListTablesResponse{Success: true}
+	if db.table {
+		resp.Tables = append(resp.Tables, operrouter.TableInfo{Name: DefaultTable})
+	}
+	return resp, nil
+}
+
+func (db *fakeDB) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*operrouter.DataSourceQueryResponse, error) {
+	if !strings.HasPrefix(query, "SELECT version, dirty FROM") {
+		return nil, errors.New("unexpected query " + query)
+	}
+	resp := &operrouter.DataSourceQueryResponse{Success: true}
+	for v, dirty := range db.versions {
+		resp.Rows = append(resp.Rows, map[string]interface{}{"version": int64(v), "dirty": dirty})
+	}
+	return resp, nil
+}
+
+func (db *fakeDB) ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*operrouter.DataSourceExecuteResponse, error) {
+	version := func() uint64 { return args[0].(uint64) }
+	switch {
+	case strings.HasPrefix(query, "CREATE TABLE IF NOT EXISTS"):
+		db.table = true
+	case strings.HasPrefix(query, "INSERT INTO"):
+		db.versions[version()] = strings.HasSuffix(query, "true)")
+	case strings.HasPrefix(query, "UPDATE") && strings.Contains(query, "dirty = TRUE"):
+		db.versions[version()] = true
+	case strings.HasPrefix(query, "UPDATE") && strings.Contains(query, "dirty = FALSE"):
+		db.versions[version()] = false
+	case strings.HasPrefix(query, "DELETE FROM"):
+		delete(db.versions, version())
+	default:
+		db.scripts = append(db.scripts, query)
+		if db.fail[query] {
+			return &operrouter.DataSourceExecuteResponse{Message: "syntax error"}, nil
+		}
+	}
+	return &operrouter.DataSourceExecuteResponse{Success: true}, nil
+}
+
+// testMigrations has versions 1, 2 and 3; version 2 has no down file
+var testMigrations = fstest.MapFS{
+	"0001_users.up.sql":    {Data: []byte("up 1")},
+	"0001_users.down.sql":  {Data: []byte("down 1")},
+	"0002_email.up.sql":    {Data: []byte("up 2")},
+	"0003_orders.up.sql":   {Data: []byte("up 3")},
+	"0003_orders.down.sql": {Data: []byte("down 3")},
+}
+
+// stepNames returns the script of each step, which testMigrations names
+// "up 1", "down 3", ...
+func stepNames(steps []Step) []string {
+	var names []string
+	for _, s := range steps {
+		names = append(names, s.SQL)
+	}
+	return names
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		applied []uint64
+		run     func(m *Migrator, ctx context.Context) ([]Step, error)
+		want    []string
+		err     string
+	}{
+		{"up from empty", nil, (*Migrator).Up, []string{"up 1", "up 2", "up 3"}, ""},
+		{"up fills gaps", []uint64{1, 3}, (*Migrator).Up, []string{"up 2"}, ""},
+		{"up when current", []uint64{1, 2, 3}, (*Migrator).Up, nil, ""},
+		{"down reverts latest", []uint64{1, 2, 3}, (*Migrator).Down, []string{"down 3"}, ""},
+		{"down from empty", nil, (*Migrator).Down, nil, ""},
+		{"down without down file", []uint64{1, 2}, (*Migrator).Down, nil, "2_email has no down file"},
+		{"down of unknown version", []uint64{1, 7}, (*Migrator).Down, nil, "version 7 is applied but has no migration files"},
+		{"to forward", []uint64{1}, toVersion(2), []string{"up 2"}, ""},
+		{"to backward", []uint64{1, 3}, toVersion(1), []string{"down 3"}, ""},
+		{"to fills gaps below", []uint64{3}, toVersion(3), []string{"up 1", "up 2"}, ""},
+		{"to reverts newest first then applies", []uint64{1, 3}, toVersion(2), []string{"down 3", "up 2"}, ""},
+		{"to zero", []uint64{1, 3}, toVersion(0), []string{"down 3", "down 1"}, ""},
+		{"to zero blocked by missing down file", []uint64{1, 2}, toVersion(0), nil, "2_email has no down file"},
+		{"to unknown version", nil, toVersion(9), nil, "no migration with version 9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeDB(tt.applied...)
+			m, err := New(db, "db", testMigrations, WithDryRun())
+			if err != nil {
+				t.Fatal(err)
+			}
+			steps, err := tt.run(m, context.Background())
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := stepNames(steps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("steps = %q, want %q", got, tt.want)
+			}
+			if len(db.scripts) > 0 || (len(tt.applied) == 0 && db.table) {
+				t.Errorf("dry run changed the database: scripts %q, table created %v", db.scripts, db.table)
+			}
+		})
+	}
+}
+
+// toVersion adapts To to the shape of the method expressions (*Migrator).Up
+// and (*Migrator).Down
+func toVersion(version uint64) func(m *Migrator, ctx context.Context) ([]Step, error) {
+	return func(m *Migrator, ctx context.Context) ([]Step, error) {
+		return m.To(ctx, version)
+	}
+}
+
+func TestUpMySQL(t *testing.T) {
+	ctx := context.Background()
+	db := newFakeDB()
+	db.fail["up 2"] = true
+	m, err := New(db, "db", testMigrations, WithDriver("mysql"), WithoutLock())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Version 2 fails part way and is left dirty
+	steps, err := m.Up(ctx)
+	var dirty *DirtyError
+	if !errors.As(err, &dirty) || dirty.Version != 2 {
+		t.Fatalf("Up error = %v, want a DirtyError for version 2", err)
+	}
+	if got := stepNames(steps); !reflect.DeepEqual(got, []string{"up 1"}) {
+		t.Errorf("steps = %q, want only up 1", got)
+	}
+	if want := map[uint64]bool{1: false, 2: true}; !reflect.DeepEqual(db.versions, want) {
+		t.Errorf("versions = %v, want %v", db.versions, want)
+	}
+
+	// Later runs refuse to continue until the version is forced
+	if _, err := m.Up(ctx); !errors.As(err, &dirty) {
+		t.Fatalf("Up error = %v, want a DirtyError", err)
+	}
+	if _, err := m.Version(ctx); !errors.As(err, &dirty) {
+		t.Fatalf("Version error = %v, want a DirtyError", err)
+	}
+
+	if err := m.Force(ctx, 2, false); err != nil {
+		t.Fatalf("Force: %v", err)
+	}
+	delete(db.fail, "up 2")
+	db.scripts = nil
+	steps, err = m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if got := stepNames(steps); !reflect.DeepEqual(got, []string{"up 2", "up 3"}) {
+		t.Errorf("steps = %q, want up 2 and up 3", got)
+	}
+	if !reflect.DeepEqual(db.scripts, []string{"up 2", "up 3"}) {
+		t.Errorf("scripts run = %q, want up 2 and up 3", db.scripts)
+	}
+	if version, err := m.Version(ctx); err != nil || version != 3 {
+		t.Errorf("Version = %d, %v, want 3", version, err)
+	}
+
+	// Down marks the version dirty, runs the script and removes the record
+	if _, err := m.Down(ctx); err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if want := map[uint64]bool{1: false, 2: false}; !reflect.DeepEqual(db.versions, want) {
+		t.Errorf("versions = %v, want %v", db.versions, want)
+	}
+
+	if err := m.Force(ctx, 3, true); err != nil {
+		t.Fatalf("Force: %v", err)
+	}
+	if dirty, ok := db.versions[3]; !ok || dirty {
+		t.Errorf("Force(3, true) left versions %v, want 3 applied and clean", db.versions)
+	}
+	if err := m.Force(ctx, 9, true); err == nil {
+		t.Error("Force accepted an unknown version")
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		err  string
+	}{
+		{"postgres", nil, ""},
+		{"qualified table", []Option{WithTable("meta.versions")}, ""},
+		{"invalid table", []Option{WithTable("versions; DROP TABLE users")}, "invalid table name"},
+		{"no lock for driver", []Option{WithDriver("sqlite")}, "no advisory lock"},
+		{"no lock needed", []Option{WithDriver("sqlite"), WithoutLock()}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(newFakeDB(), "db", testMigrations, tt.opts...)
+			if tt.err == "" && err != nil {
+				t.Errorf("New: %v", err)
+			}
+			if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Errorf("New error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
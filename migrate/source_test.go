@@ -0,0 +1,72 @@
+package migrate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestReadMigrations(t *testing.T) {
+	file := func(data string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(data)} }
+
+	tests := []struct {
+		name  string
+		fsys  fstest.MapFS
+		want  []Migration
+		error string
+	}{
+		{"empty", fstest.MapFS{}, []Migration{}, ""},
+		{
+			"ordered by version",
+			fstest.MapFS{
+				"10_add_index.up.sql":       file("CREATE INDEX"),
+				"0002_add_email.up.sql":     file("ALTER TABLE"),
+				"0002_add_email.down.sql":   file("ALTER TABLE DROP"),
+				"0001_create_users.up.sql":  file("CREATE TABLE"),
+				"README.md":                 file("ignored"),
+				"seeds/0003_seed.up.sql":    file("ignored"),
+				"0001_create_users.txt.bak": file("ignored"),
+			},
+			[]Migration{
+				{Version: 1, Name: "create_users", Up: "CREATE TABLE"},
+				{Version: 2, Name: "add_email", Up: "ALTER TABLE", Down: "ALTER TABLE DROP"},
+				{Version: 10, Name: "add_index", Up: "CREATE INDEX"},
+			},
+			"",
+		},
+		{"bad name", fstest.MapFS{"create_users.sql": file("")}, nil, "name must look like"},
+		{"bad direction", fstest.MapFS{"0001_users.sideways.sql": file("")}, nil, "name must look like"},
+		{"version zero", fstest.MapFS{"0000_init.up.sql": file("")}, nil, "version 0 is reserved"},
+		{"version overflow", fstest.MapFS{"99999999999999999999_big.up.sql": file("")}, nil, "invalid version"},
+		{"down without up", fstest.MapFS{"0001_users.down.sql": file("")}, nil, "migration 1_users has no up file"},
+		{
+			"version reused",
+			fstest.MapFS{"0001_users.up.sql": file(""), "1_accounts.up.sql": file("")},
+			nil,
+			"version 1 is used by both",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := readMigrations(tt.fsys)
+			if tt.error != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.error) {
+					t.Fatalf("readMigrations error = %v, want %q", err, tt.error)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readMigrations: %v", err)
+			}
+			got := make([]Migration, len(migrations))
+			for i, m := range migrations {
+				got[i] = *m
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readMigrations = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
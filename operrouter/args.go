@@ -0,0 +1,72 @@
+package operrouter
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// Placeholder returns the bind placeholder for the n-th argument (1-based)
+// in the dialect of driver: "$n" for PostgreSQL and "?" otherwise
+func Placeholder(driver string, n int) string {
+	switch driver {
+	case "postgres", "postgresql":
+		return "$" + strconv.Itoa(n)
+	default:
+		return "?"
+	}
+}
+
+// bindArgs encodes query arguments. Values of type sql.NamedArg (see
+// sql.Named) become named arguments; everything else is positional.
+func bindArgs(args []interface{}) ([]*pb.Value, map[string]*pb.Value, error) {
+	var positional []*pb.Value
+	var named map[string]*pb.Value
+	for i, arg := range args {
+		if namedArg, ok := arg.(sql.NamedArg); ok {
+			if namedArg.Name == "" {
+				return nil, nil, fmt.Errorf("argument %d: named argument has no name", i+1)
+			}
+			value, err := GoToValue(namedArg.Value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("argument %s: %w", namedArg.Name, err)
+			}
+			if named == nil {
+				named = make(map[string]*pb.Value)
+			}
+			named[namedArg.Name] = value
+			continue
+		}
+		value, err := GoToValue(arg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("argument %d: %w", i+1, err)
+		}
+		positional = append(positional, value)
+	}
+	return positional, named, nil
+}
+
+// addJSONArgs adds the "args" and "named_args" JSON-RPC params when present
+func addJSONArgs(params map[string]interface{}, args []interface{}) error {
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		values := make([]interface{}, len(positional))
+		for i, v := range positional {
+			values[i] = ValueToGo(v)
+		}
+		params["args"] = values
+	}
+	if len(named) > 0 {
+		values := make(map[string]interface{}, len(named))
+		for k, v := range named {
+			values[k] = ValueToGo(v)
+		}
+		params["named_args"] = values
+	}
+	return nil
+}
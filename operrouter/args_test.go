@@ -0,0 +1,131 @@
+package operrouter
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPlaceholder(t *testing.T) {
+	tests := []struct {
+		driver string
+		n      int
+		want   string
+	}{
+		{"postgres", 1, "$1"},
+		{"postgresql", 12, "$12"},
+		{"mysql", 1, "?"},
+		{"mysql", 3, "?"},
+		{"sqlite", 2, "?"},
+		{"", 1, "?"},
+	}
+
+	for _, tt := range tests {
+		if got := Placeholder(tt.driver, tt.n); got != tt.want {
+			t.Errorf("Placeholder(%q, %d) = %q, want %q", tt.driver, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestBindArgs(t *testing.T) {
+	when := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		args           []interface{}
+		wantPositional []interface{}
+		wantNamed      map[string]interface{}
+	}{
+		{"none", nil, nil, nil},
+		{
+			"positional",
+			[]interface{}{1, "a", nil, when, sql.NullInt64{}},
+			[]interface{}{int64(1), "a", nil, "2024-01-02T03:04:05Z", nil},
+			nil,
+		},
+		{
+			"named",
+			[]interface{}{sql.Named("id", 7), sql.Named("tags", []string{"x"})},
+			nil,
+			map[string]interface{}{"id": int64(7), "tags": []interface{}{"x"}},
+		},
+		{
+			"mixed keeps positional order",
+			[]interface{}{"first", sql.Named("n", true), "second"},
+			[]interface{}{"first", "second"},
+			map[string]interface{}{"n": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			positional, named, err := bindArgs(tt.args)
+			if err != nil {
+				t.Fatalf("bindArgs: %v", err)
+			}
+
+			var gotPositional []interface{}
+			for _, v := range positional {
+				gotPositional = append(gotPositional, ValueToGo(v))
+			}
+			if !reflect.DeepEqual(gotPositional, tt.wantPositional) {
+				t.Errorf("positional = %#v, want %#v", gotPositional, tt.wantPositional)
+			}
+
+			var gotNamed map[string]interface{}
+			for k, v := range named {
+				if gotNamed == nil {
+					gotNamed = make(map[string]interface{})
+				}
+				gotNamed[k] = ValueToGo(v)
+			}
+			if !reflect.DeepEqual(gotNamed, tt.wantNamed) {
+				t.Errorf("named = %#v, want %#v", gotNamed, tt.wantNamed)
+			}
+		})
+	}
+}
+
+func TestBindArgsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []interface{}
+		want string
+	}{
+		{"unnamed named argument", []interface{}{1, sql.Named("", 2)}, "argument 2: named argument has no name"},
+		{"unsupported positional", []interface{}{"ok", make(chan int)}, "argument 2: unsupported type chan int"},
+		{"unsupported named", []interface{}{sql.Named("fn", func() {})}, "argument fn: unsupported type func()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := bindArgs(tt.args)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("bindArgs error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddJSONArgs(t *testing.T) {
+	params := map[string]interface{}{}
+	if err := addJSONArgs(params, []interface{}{1, sql.Named("name", "x")}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"args":       []interface{}{int64(1)},
+		"named_args": map[string]interface{}{"name": "x"},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params = %#v, want %#v", params, want)
+	}
+
+	params = map[string]interface{}{}
+	if err := addJSONArgs(params, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(params) != 0 {
+		t.Errorf("params = %#v, want none without arguments", params)
+	}
+}
@@ -0,0 +1,271 @@
+package operrouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// Role is the author of a chat message
+type Role string
+
+const (
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+	// RoleTool carries the result of a tool call back to the model
+	RoleTool Role = "tool"
+)
+
+// PartType tells the kinds of ContentPart apart
+type PartType string
+
+const (
+	PartText  PartType = "text"
+	PartImage PartType = "image"
+)
+
+// ContentPart is one piece of a multimodal message.
+// Use TextPart, ImageURLPart or ImageDataPart to build one.
+type ContentPart struct {
+	Type PartType
+	Text string
+	// ImageURL or ImageData is set for PartImage
+	ImageURL  string
+	ImageData []byte
+	// MIMEType describes ImageData, e.g. "image/png"
+	MIMEType string
+}
+
+// TextPart returns a text part
+func TextPart(text string) ContentPart {
+	return ContentPart{Type: PartText, Text: text}
+}
+
+// ImageURLPart returns an image part the provider fetches from url
+func ImageURLPart(url string) ContentPart {
+	return ContentPart{Type: PartImage, ImageURL: url}
+}
+
+// ImageDataPart returns an image part carrying the image itself
+func ImageDataPart(mimeType string, data []byte) ContentPart {
+	return ContentPart{Type: PartImage, ImageData: data, MIMEType: mimeType}
+}
+
+// ChatMessage is one message of a ChatLLM conversation
+type ChatMessage struct {
+	Role Role
+	// Name identifies the participant, or the tool for RoleTool messages
+	Name string
+	// Content is the text of the message; it is ignored when Parts is set
+	Content string
+	// Parts holds multimodal content, e.g. text followed by an image
+	Parts []ContentPart
+	// ToolCalls are the calls requested by an assistant message, as returned
+	// in LLMGenerateResponse.ToolCalls
+	ToolCalls []ToolCall
+	// ToolCallID is the call a RoleTool message answers
+	ToolCallID string
+}
+
+// SystemMessage returns a system message
+func SystemMessage(content string) ChatMessage {
+	return ChatMessage{Role: RoleSystem, Content: content}
+}
+
+// UserMessage returns a user message. Passing parts makes it multimodal, and
+// content is then ignored.
+func UserMessage(content string, parts ...ContentPart) ChatMessage {
+	return ChatMessage{Role: RoleUser, Content: content, Parts: parts}
+}
+
+// AssistantMessage returns an assistant message, e.g. an earlier reply
+func AssistantMessage(content string) ChatMessage {
+	return ChatMessage{Role: RoleAssistant, Content: content}
+}
+
+// messageRoleToProto maps a Role onto the proto enum, rejecting unknown roles
+func messageRoleToProto(role Role) (pb.MessageRole, error) {
+	switch role {
+	case RoleSystem:
+		return pb.MessageRole_MESSAGE_ROLE_SYSTEM, nil
+	case RoleUser:
+		return pb.MessageRole_MESSAGE_ROLE_USER, nil
+	case RoleAssistant:
+		return pb.MessageRole_MESSAGE_ROLE_ASSISTANT, nil
+	case RoleTool:
+		return pb.MessageRole_MESSAGE_ROLE_TOOL, nil
+	default:
+		return 0, fmt.Errorf("unknown role %q", role)
+	}
+}
+
+// chatMessagesToProto validates and converts chat messages
+func chatMessagesToProto(messages []ChatMessage) ([]*pb.LLMMessage, error) {
+	protoMessages := make([]*pb.LLMMessage, len(messages))
+	for i, msg := range messages {
+		role, err := messageRoleToProto(msg.Role)
+		if err != nil {
+			return nil, fmt.Errorf("chat llm failed: message %d: %w", i, err)
+		}
+		protoMsg := &pb.LLMMessage{
+			Role:    role,
+			Content: msg.Content,
+		}
+		if msg.Name != "" {
+			protoMsg.Name = &msg.Name
+		}
+		for j, part := range msg.Parts {
+			protoPart, err := contentPartToProto(part)
+			if err != nil {
+				return nil, fmt.Errorf("chat llm failed: message %d: part %d: %w", i, j, err)
+			}
+			protoMsg.Parts = append(protoMsg.Parts, protoPart)
+		}
+		if msg.Role == RoleTool {
+			if msg.ToolCallID == "" {
+				return nil, fmt.Errorf("chat llm failed: message %d: tool message has no ToolCallID", i)
+			}
+			protoMsg.ToolCallId = &msg.ToolCallID
+		}
+		if len(msg.ToolCalls) > 0 {
+			if msg.Role != RoleAssistant {
+				return nil, fmt.Errorf("chat llm failed: message %d: only assistant messages carry tool calls", i)
+			}
+			if protoMsg.ToolCalls, err = toolCallsToProto(msg.ToolCalls); err != nil {
+				return nil, fmt.Errorf("chat llm failed: message %d: %w", i, err)
+			}
+		}
+		protoMessages[i] = protoMsg
+	}
+	return protoMessages, nil
+}
+
+// chatRequest validates and builds a ChatLLMRequest
+func chatRequest(name string, messages []ChatMessage, opts []LLMOption) (*pb.ChatLLMRequest, error) {
+	o := newLLMOptions(opts)
+	protoMessages, err := chatMessagesToProto(messages)
+	if err != nil {
+		return nil, err
+	}
+	tools, err := toolsToProto(o.tools)
+	if err != nil {
+		return nil, fmt.Errorf("chat llm failed: %w", err)
+	}
+	if err := checkToolChoice(o.toolChoice, o.tools); err != nil {
+		return nil, fmt.Errorf("chat llm failed: %w", err)
+	}
+	options, err := o.generation()
+	if err != nil {
+		return nil, fmt.Errorf("chat llm failed: %w", err)
+	}
+	return &pb.ChatLLMRequest{
+		Name:       name,
+		Messages:   protoMessages,
+		Tools:      tools,
+		ToolChoice: o.toolChoice,
+		Options:    options,
+	}, nil
+}
+
+// contentPartToProto validates and converts one content part
+func contentPartToProto(part ContentPart) (*pb.ContentPart, error) {
+	switch part.Type {
+	case PartText:
+		return &pb.ContentPart{Part: &pb.ContentPart_Text{Text: part.Text}}, nil
+	case PartImage:
+		if (part.ImageURL == "") == (len(part.ImageData) == 0) {
+			return nil, fmt.Errorf("image needs either a URL or data")
+		}
+		return &pb.ContentPart{Part: &pb.ContentPart_Image{Image: &pb.ImagePart{
+			Url:      part.ImageURL,
+			Data:     part.ImageData,
+			MimeType: part.MIMEType,
+		}}}, nil
+	default:
+		return nil, fmt.Errorf("unknown part type %q", part.Type)
+	}
+}
+
+// chatMessagesJSON encodes converted messages for JSON-RPC, mirroring the
+// proto fields: {"role", "content", "name", "parts": [{"type": "text", "text"}
+// or {"type": "image", "url", "data" (base64), "mime_type"}], "tool_call_id",
+// "tool_calls": [{"id", "name", "arguments" (JSON text)}]}
+func chatMessagesJSON(protoMessages []*pb.LLMMessage) []map[string]interface{} {
+	messages := make([]map[string]interface{}, len(protoMessages))
+	for i, m := range protoMessages {
+		// "system", "user", "assistant" or "tool"
+		role := Role(strings.ToLower(strings.TrimPrefix(m.Role.String(), "MESSAGE_ROLE_")))
+		msg := map[string]interface{}{
+			"role":    role,
+			"content": m.Content,
+		}
+		if m.Name != nil {
+			msg["name"] = *m.Name
+		}
+		if len(m.Parts) > 0 {
+			parts := make([]map[string]interface{}, len(m.Parts))
+			for j, p := range m.Parts {
+				if image := p.GetImage(); image != nil {
+					part := map[string]interface{}{"type": PartImage}
+					if image.Url != "" {
+						part["url"] = image.Url
+					} else {
+						part["data"] = image.Data
+						part["mime_type"] = image.MimeType
+					}
+					parts[j] = part
+				} else {
+					parts[j] = map[string]interface{}{"type": PartText, "text": p.GetText()}
+				}
+			}
+			msg["parts"] = parts
+		}
+		if m.ToolCallId != nil {
+			msg["tool_call_id"] = *m.ToolCallId
+		}
+		if len(m.ToolCalls) > 0 {
+			calls := make([]map[string]interface{}, len(m.ToolCalls))
+			for j, call := range m.ToolCalls {
+				calls[j] = map[string]interface{}{
+					"id":        call.Id,
+					"name":      call.Name,
+					"arguments": call.ArgumentsJson,
+				}
+			}
+			msg["tool_calls"] = calls
+		}
+		messages[i] = msg
+	}
+	return messages
+}
+
+// chatParams encodes a ChatLLMRequest as JSON-RPC params
+func chatParams(req *pb.ChatLLMRequest) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":     req.Name,
+		"messages": chatMessagesJSON(req.Messages),
+	}
+	if len(req.Tools) > 0 {
+		tools := make([]map[string]interface{}, len(req.Tools))
+		for i, tool := range req.Tools {
+			t := map[string]interface{}{
+				"name":        tool.Name,
+				"description": tool.Description,
+			}
+			if tool.ParametersJson != "" {
+				// Sent as a JSON object, not as text
+				t["parameters"] = json.RawMessage(tool.ParametersJson)
+			}
+			tools[i] = t
+		}
+		params["tools"] = tools
+	}
+	if req.ToolChoice != "" {
+		params["tool_choice"] = req.ToolChoice
+	}
+	addGenerationParams(params, req.Options)
+	return params
+}
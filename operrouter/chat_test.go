@@ -0,0 +1,125 @@
+package operrouter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChatMessagesToProto(t *testing.T) {
+	name := "alice"
+	callID := "call_1"
+	tool := "weather"
+
+	got, err := chatMessagesToProto([]ChatMessage{
+		SystemMessage("be brief"),
+		{Role: RoleUser, Name: "alice", Content: "hi"},
+		UserMessage("ignored", TextPart("what is this?"), ImageURLPart("https://example.com/cat.png")),
+		UserMessage("", ImageDataPart("image/png", []byte{0x89, 'P'})),
+		AssistantMessage("a cat"),
+		{Role: RoleTool, Name: "weather", ToolCallID: "call_1", Content: "sunny"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*pb.LLMMessage{
+		{Role: pb.MessageRole_MESSAGE_ROLE_SYSTEM, Content: "be brief"},
+		{Role: pb.MessageRole_MESSAGE_ROLE_USER, Content: "hi", Name: &name},
+		{Role: pb.MessageRole_MESSAGE_ROLE_USER, Content: "ignored", Parts: []*pb.ContentPart{
+			{Part: &pb.ContentPart_Text{Text: "what is this?"}},
+			{Part: &pb.ContentPart_Image{Image: &pb.ImagePart{Url: "https://example.com/cat.png"}}},
+		}},
+		{Role: pb.MessageRole_MESSAGE_ROLE_USER, Parts: []*pb.ContentPart{
+			{Part: &pb.ContentPart_Image{Image: &pb.ImagePart{Data: []byte{0x89, 'P'}, MimeType: "image/png"}}},
+		}},
+		{Role: pb.MessageRole_MESSAGE_ROLE_ASSISTANT, Content: "a cat"},
+		{Role: pb.MessageRole_MESSAGE_ROLE_TOOL, Content: "sunny", Name: &tool, ToolCallId: &callID},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%d messages, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("message %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestChatMessagesToProtoErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []ChatMessage
+		err      string
+	}{
+		{
+			"unknown role",
+			[]ChatMessage{SystemMessage("s"), {Role: "developer", Content: "x"}},
+			`message 1: unknown role "developer"`,
+		},
+		{"empty role", []ChatMessage{{Content: "x"}}, `message 0: unknown role ""`},
+		{
+			"tool message without call ID",
+			[]ChatMessage{{Role: RoleTool, Name: "weather", Content: "sunny"}},
+			"message 0: tool message has no ToolCallID",
+		},
+		{
+			"image without source",
+			[]ChatMessage{UserMessage("", TextPart("a"), ContentPart{Type: PartImage})},
+			"message 0: part 1: image needs either a URL or data",
+		},
+		{
+			"image with URL and data",
+			[]ChatMessage{UserMessage("", ContentPart{Type: PartImage, ImageURL: "https://example.com/a.png", ImageData: []byte{1}})},
+			"message 0: part 0: image needs either a URL or data",
+		},
+		{
+			"unknown part type",
+			[]ChatMessage{UserMessage("", ContentPart{Type: "audio"})},
+			`message 0: part 0: unknown part type "audio"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chatMessagesToProto(tt.messages)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestChatMessagesJSON(t *testing.T) {
+	protoMessages, err := chatMessagesToProto([]ChatMessage{
+		{Role: RoleUser, Name: "alice", Content: "look", Parts: []ContentPart{
+			TextPart("what is this?"),
+			ImageURLPart("https://example.com/cat.png"),
+			ImageDataPart("image/png", []byte{1, 2}),
+		}},
+		{Role: RoleTool, Name: "weather", ToolCallID: "call_1", Content: "sunny"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]interface{}{
+		{
+			"role":    RoleUser,
+			"content": "look",
+			"name":    "alice",
+			"parts": []map[string]interface{}{
+				{"type": PartText, "text": "what is this?"},
+				{"type": PartImage, "url": "https://example.com/cat.png"},
+				{"type": PartImage, "data": []byte{1, 2}, "mime_type": "image/png"},
+			},
+		},
+		{"role": RoleTool, "content": "sunny", "name": "weather", "tool_call_id": "call_1"},
+	}
+	if got := chatMessagesJSON(protoMessages); !reflect.DeepEqual(got, want) {
+		t.Errorf("chatMessagesJSON = %v, want %v", got, want)
+	}
+}
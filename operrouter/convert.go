@@ -0,0 +1,139 @@
+package operrouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// toFloat64 converts the numeric types callers commonly use, including
+// json.Number from a decoder with UseNumber, to float64
+func toFloat64(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	case int:
+		return float64(n), nil
+	case int8:
+		return float64(n), nil
+	case int16:
+		return float64(n), nil
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case uint:
+		return float64(n), nil
+	case uint8:
+		return float64(n), nil
+	case uint16:
+		return float64(n), nil
+	case uint32:
+		return float64(n), nil
+	case uint64:
+		return float64(n), nil
+	case json.Number:
+		return n.Float64()
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+}
+
+// toUint64 converts a non-negative whole number of any common numeric type to uint64
+func toUint64(v interface{}) (uint64, error) {
+	switch n := v.(type) {
+	case int:
+		return intToUint64(int64(n))
+	case int8:
+		return intToUint64(int64(n))
+	case int16:
+		return intToUint64(int64(n))
+	case int32:
+		return intToUint64(int64(n))
+	case int64:
+		return intToUint64(n)
+	case uint:
+		return uint64(n), nil
+	case uint8:
+		return uint64(n), nil
+	case uint16:
+		return uint64(n), nil
+	case uint32:
+		return uint64(n), nil
+	case uint64:
+		return n, nil
+	case json.Number:
+		if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+			return u, nil
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("expected a whole number, got %q", n.String())
+		}
+		return floatToUint64(f)
+	case float32:
+		return floatToUint64(float64(n))
+	case float64:
+		return floatToUint64(n)
+	default:
+		return 0, fmt.Errorf("expected a whole number, got %T", v)
+	}
+}
+
+// toUint32 is toUint64 limited to the uint32 range
+func toUint32(v interface{}) (uint32, error) {
+	u, err := toUint64(v)
+	if err != nil {
+		return 0, err
+	}
+	if u > math.MaxUint32 {
+		return 0, fmt.Errorf("%d is out of range", u)
+	}
+	return uint32(u), nil
+}
+
+func intToUint64(n int64) (uint64, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("expected a non-negative number, got %d", n)
+	}
+	return uint64(n), nil
+}
+
+func floatToUint64(f float64) (uint64, error) {
+	if f < 0 || f != math.Trunc(f) || f > math.MaxUint64 {
+		return 0, fmt.Errorf("expected a non-negative whole number, got %v", f)
+	}
+	return uint64(f), nil
+}
+
+// toString accepts string values only; other types are reported, not formatted
+func toString(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string, got %T", v)
+	}
+	return s, nil
+}
+
+// unknownKeys lists the keys of config that are not in known, sorted for stable errors
+func unknownKeys(config map[string]interface{}, known ...string) []string {
+	var unknown []string
+	for k := range config {
+		found := false
+		for _, name := range known {
+			if k == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
@@ -0,0 +1,144 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"sync"
+)
+
+// page is one message of a paged stream, decoded by a backend
+type page[T any] struct {
+	Success bool
+	Items   []T
+	Done    bool
+	Error   string
+}
+
+// pagedStream hands out the items of a stream whose messages carry pages of
+// items, holding one page in memory at a time
+type pagedStream[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	recv   func() (*page[T], error)
+	// op names the operation in errors, e.g. "stream query"
+	op string
+
+	mu       sync.Mutex
+	items    []T
+	finished bool
+}
+
+// next returns the next item, or io.EOF once the stream is complete
+func (s *pagedStream[T]) next() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var zero T
+	for len(s.items) == 0 {
+		if s.finished {
+			return zero, io.EOF
+		}
+
+		p, err := s.recv()
+		if err != nil {
+			// Read ctx before finish cancels it
+			ctxErr := s.ctx.Err()
+			s.finish()
+			if errors.Is(err, io.EOF) {
+				return zero, io.EOF
+			}
+			if ctxErr != nil && !errors.Is(err, ctxErr) {
+				return zero, fmt.Errorf("%s failed: %w: %v", s.op, ctxErr, err)
+			}
+			return zero, fmt.Errorf("%s failed: %w", s.op, err)
+		}
+
+		if !p.Success && p.Error != "" {
+			s.finish()
+			return zero, fmt.Errorf("%s failed: %s", s.op, p.Error)
+		}
+		if p.Done {
+			s.finish()
+		}
+		s.items = p.Items
+	}
+
+	item := s.items[0]
+	s.items[0] = zero
+	s.items = s.items[1:]
+	return item, nil
+}
+
+// all iterates over the remaining items and closes the stream when the loop
+// ends, including on early break
+func (s *pagedStream[T]) all() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer s.close()
+		for {
+			item, err := s.next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// close stops the stream; next then returns io.EOF
+func (s *pagedStream[T]) close() {
+	// Cancel first: it unblocks a next waiting on the backend
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items = nil
+	s.finished = true
+}
+
+// finish marks the stream complete and releases the backend; s.mu must be held
+func (s *pagedStream[T]) finish() {
+	s.finished = true
+	s.cancel()
+}
+
+// rowPage is one message of a streamed query
+type rowPage = page[map[string]interface{}]
+
+// RowCursor iterates over the rows of a streamed query. Rows arrive in pages,
+// so only one page is held in memory at a time.
+// Call Next until it returns io.EOF, or range over Rows. Close releases the
+// cursor early; cancelling the context passed to StreamQueryDataSource does
+// the same.
+type RowCursor struct {
+	stream *pagedStream[map[string]interface{}]
+}
+
+// newRowCursor wraps a backend receive function; cancel must release every
+// resource held by the backend stream
+func newRowCursor(ctx context.Context, cancel context.CancelFunc, recv func() (*rowPage, error)) *RowCursor {
+	return &RowCursor{stream: &pagedStream[map[string]interface{}]{
+		ctx: ctx, cancel: cancel, recv: recv, op: "stream query",
+	}}
+}
+
+// Next returns the next row, or io.EOF once every row has been read
+func (c *RowCursor) Next() (map[string]interface{}, error) {
+	return c.stream.next()
+}
+
+// Rows iterates over the remaining rows and closes the cursor when the loop
+// ends, including on early break
+func (c *RowCursor) Rows() iter.Seq2[map[string]interface{}, error] {
+	return c.stream.all()
+}
+
+// Close stops the query and releases its resources; Next then returns io.EOF
+func (c *RowCursor) Close() error {
+	c.stream.close()
+	return nil
+}
@@ -0,0 +1,165 @@
+package operrouter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRowCursorBreak(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	released := false
+	pages := 0
+	cursor := newRowCursor(ctx, func() {
+		released = true
+		cancel()
+	}, func() (*rowPage, error) {
+		pages++
+		if pages == 1 {
+			return &rowPage{Success: true, Items: []map[string]interface{}{{"id": int64(1)}, {"id": int64(2)}}}, nil
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	var got []map[string]interface{}
+	for row, err := range cursor.Rows() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, row)
+		break
+	}
+
+	if want := []map[string]interface{}{{"id": int64(1)}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %v, want %v", got, want)
+	}
+	if !released || ctx.Err() == nil {
+		t.Errorf("released %v, ctx error %v: want the stream cancelled on break", released, ctx.Err())
+	}
+	if _, err := cursor.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Next after break = %v, want io.EOF", err)
+	}
+	if pages != 1 {
+		t.Errorf("%d pages read, want no read after break", pages)
+	}
+}
+
+func TestRowCursorPages(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages []*rowPage
+		err   error // returned by recv after the pages
+		want  []int64
+		wantE string // "" for io.EOF
+	}{
+		{
+			"pages until done",
+			[]*rowPage{
+				{Success: true, Items: []map[string]interface{}{{"id": int64(1)}}},
+				{Success: true},
+				{Success: true, Items: []map[string]interface{}{{"id": int64(2)}, {"id": int64(3)}}, Done: true},
+			},
+			errors.New("read past done"),
+			[]int64{1, 2, 3},
+			"",
+		},
+		{
+			"server error",
+			[]*rowPage{
+				{Success: true, Items: []map[string]interface{}{{"id": int64(1)}}},
+				{Error: "relation does not exist"},
+			},
+			nil,
+			[]int64{1},
+			"stream query failed: relation does not exist",
+		},
+		{
+			"backend error",
+			[]*rowPage{{Success: true, Items: []map[string]interface{}{{"id": int64(1)}}}},
+			io.ErrUnexpectedEOF,
+			[]int64{1},
+			"stream query failed: unexpected EOF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			pages := tt.pages
+			cursor := newRowCursor(ctx, cancel, func() (*rowPage, error) {
+				if len(pages) == 0 {
+					return nil, tt.err
+				}
+				p := pages[0]
+				pages = pages[1:]
+				return p, nil
+			})
+
+			var got []int64
+			var err error
+			for {
+				var row map[string]interface{}
+				if row, err = cursor.Next(); err != nil {
+					break
+				}
+				got = append(got, row["id"].(int64))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ids = %v, want %v", got, tt.want)
+			}
+			if tt.wantE == "" {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("error = %v, want io.EOF", err)
+				}
+			} else if err == nil || err.Error() != tt.wantE || errors.Is(err, context.Canceled) {
+				t.Errorf("error = %v, want %q", err, tt.wantE)
+			}
+			if ctx.Err() == nil {
+				t.Error("stream not released at its end")
+			}
+		})
+	}
+}
+
+func TestHTTPStreamQueryBreak(t *testing.T) {
+	serverDone := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(serverDone)
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"result": map[string]interface{}{"success": true, "rows": []map[string]interface{}{{"id": 1}, {"id": 2}}},
+		})
+		w.(http.Flusher).Flush()
+		// Hold the stream open until the client goes away
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	client, err := NewHTTPWithOptions(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor, err := client.StreamQueryDataSource(context.Background(), "db", 100, "SELECT id FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range cursor.Rows() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+
+	select {
+	case <-serverDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server stream still open after the loop broke")
+	}
+}
@@ -0,0 +1,227 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+const (
+	// DefaultEmbeddingBatchSize is the number of texts sent per call by
+	// BatchEmbeddingLLM unless WithEmbeddingBatchSize is given; it fits the
+	// input limits of common providers
+	DefaultEmbeddingBatchSize = 96
+	// DefaultEmbeddingConcurrency is the number of calls BatchEmbeddingLLM
+	// runs at once unless WithEmbeddingConcurrency is given
+	DefaultEmbeddingConcurrency = 4
+)
+
+// EmbeddingOption configures BatchEmbeddingLLM
+type EmbeddingOption func(*embeddingOptions)
+
+// embeddingOptions holds the settings collected from EmbeddingOption values
+type embeddingOptions struct {
+	batchSize   int
+	concurrency int
+}
+
+// WithEmbeddingBatchSize sets how many texts are sent per call.
+// A size of 0 or less sends all texts in a single call.
+func WithEmbeddingBatchSize(size int) EmbeddingOption {
+	return func(o *embeddingOptions) {
+		o.batchSize = size
+	}
+}
+
+// WithEmbeddingConcurrency sets how many calls run at once.
+// A limit of 0 or less runs one call at a time.
+func WithEmbeddingConcurrency(concurrency int) EmbeddingOption {
+	return func(o *embeddingOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// EmbeddingResult is the embedding of one input text
+type EmbeddingResult struct {
+	Embedding []float64
+	// Err is set instead when the text could not be embedded
+	Err error
+}
+
+// LLMBatchEmbeddingResponse holds the embeddings of a BatchEmbeddingLLM call
+type LLMBatchEmbeddingResponse struct {
+	// Success is false when any text failed; Message then describes the failures
+	Success bool
+	Message string
+	// Results holds one result per input text, in input order
+	Results []EmbeddingResult
+
+	// Model is the model that produced the embeddings
+	Model string
+	// TokensUsed is the token count reported by the provider over all
+	// sub-batches, 0 if unknown
+	TokensUsed uint32
+}
+
+// Embeddings returns the embeddings in input order, or the error of the first
+// text that failed
+func (r *LLMBatchEmbeddingResponse) Embeddings() ([][]float64, error) {
+	embeddings := make([][]float64, len(r.Results))
+	for i, result := range r.Results {
+		if result.Err != nil {
+			return nil, fmt.Errorf("text %d: %w", i, result.Err)
+		}
+		embeddings[i] = result.Embedding
+	}
+	return embeddings, nil
+}
+
+// embedBatchFunc embeds one sub-batch of texts
+type embedBatchFunc func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error)
+
+// batchEmbed splits texts into sub-batches, embeds up to the concurrency
+// bound of them at once and gathers the results in input order. A failed
+// sub-batch sets the error of each of its texts rather than failing the call;
+// only the end of ctx fails the whole call.
+func batchEmbed(ctx context.Context, name string, texts []string, opts []EmbeddingOption, embedBatch embedBatchFunc) (*LLMBatchEmbeddingResponse, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	o := embeddingOptions{batchSize: DefaultEmbeddingBatchSize, concurrency: DefaultEmbeddingConcurrency}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	size := o.batchSize
+	if size <= 0 || size > len(texts) {
+		size = max(len(texts), 1)
+	}
+	concurrency := max(o.concurrency, 1)
+
+	results := make([]EmbeddingResult, len(texts))
+	batches := make([]*LLMBatchEmbeddingResponse, (len(texts)+size-1)/size)
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(batches)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range next {
+				start := b * size
+				end := min(start+size, len(texts))
+				batches[b] = embedSubBatch(ctx, name, texts[start:end], embedBatch, results[start:end])
+			}
+		}()
+	}
+dispatch:
+	for b := range batches {
+		// Sub-batches not yet started are dropped once ctx ends; select
+		// alone would pick at random while a worker is also ready
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case next <- b:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(next)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("batch embedding llm failed: %w", err)
+	}
+
+	resp := &LLMBatchEmbeddingResponse{Success: true, Results: results}
+	for _, batch := range batches {
+		if batch == nil {
+			continue
+		}
+		if resp.Model == "" {
+			resp.Model = batch.Model
+		}
+		resp.TokensUsed += batch.TokensUsed
+	}
+	failed, first := 0, -1
+	for i, result := range results {
+		if result.Err != nil {
+			if first < 0 {
+				first = i
+			}
+			failed++
+		}
+	}
+	if failed > 0 {
+		resp.Success = false
+		resp.Message = fmt.Sprintf("%d of %d texts failed, first at %d: %v", failed, len(texts), first, results[first].Err)
+	}
+	return resp, nil
+}
+
+// embedSubBatch embeds texts into results, which has the same length. It
+// returns the backend response, or nil when the call failed.
+func embedSubBatch(ctx context.Context, name string, texts []string, embedBatch embedBatchFunc, results []EmbeddingResult) *LLMBatchEmbeddingResponse {
+	fail := func(err error) {
+		for i := range results {
+			results[i].Err = err
+		}
+	}
+
+	resp, err := embedBatch(ctx, name, texts)
+	if err != nil {
+		fail(err)
+		return nil
+	}
+	if len(resp.Results) != len(texts) {
+		if !resp.Success {
+			fail(batchError(resp.Message))
+		} else {
+			fail(fmt.Errorf("batch embedding llm failed: got %d results for %d texts", len(resp.Results), len(texts)))
+		}
+		return resp
+	}
+	copy(results, resp.Results)
+	if !resp.Success {
+		// Texts without an embedding of their own share the batch error
+		for i := range results {
+			if results[i].Err == nil && len(results[i].Embedding) == 0 {
+				results[i].Err = batchError(resp.Message)
+			}
+		}
+	}
+	return resp
+}
+
+// batchError is the error of a sub-batch the backend reported as failed
+func batchError(message string) error {
+	if message == "" {
+		return errors.New("batch embedding llm failed")
+	}
+	return errors.New(message)
+}
+
+// batchEmbeddingResponse converts the response to one sub-batch
+func batchEmbeddingResponse(resp *pb.BatchEmbeddingLLMResponse) *LLMBatchEmbeddingResponse {
+	results := make([]EmbeddingResult, len(resp.Results))
+	for i, r := range resp.Results {
+		if r.Error != "" {
+			results[i].Err = errors.New(r.Error)
+			continue
+		}
+		// Convert []float32 to []float64
+		results[i].Embedding = make([]float64, len(r.Embedding))
+		for j, v := range r.Embedding {
+			results[i].Embedding[j] = float64(v)
+		}
+	}
+	return &LLMBatchEmbeddingResponse{
+		Success:    resp.Success,
+		Message:    resp.Error,
+		Results:    results,
+		Model:      resp.Model,
+		TokensUsed: resp.GetTokensUsed(),
+	}
+}
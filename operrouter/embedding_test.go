@@ -0,0 +1,249 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeEmbed embeds each text as the single number it spells, and fails the
+// sub-batches that start at a text listed in fail
+func fakeEmbed(fail map[string]error) embedBatchFunc {
+	return func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+		if err := fail[texts[0]]; err != nil {
+			return nil, err
+		}
+		resp := &LLMBatchEmbeddingResponse{Success: true, Model: "m", TokensUsed: uint32(len(texts))}
+		for _, text := range texts {
+			n, _ := strconv.Atoi(text)
+			resp.Results = append(resp.Results, EmbeddingResult{Embedding: []float64{float64(n)}})
+		}
+		return resp, nil
+	}
+}
+
+func TestBatchEmbed(t *testing.T) {
+	tests := []struct {
+		name        string
+		texts       []string
+		opts        []EmbeddingOption
+		wantBatches int
+		want        [][]float64
+	}{
+		{"no texts", nil, nil, 0, [][]float64{}},
+		{"default size", []string{"3", "1", "4", "1", "5"}, nil, 1, [][]float64{{3}, {1}, {4}, {1}, {5}}},
+		{
+			"exact batches",
+			[]string{"3", "1", "4", "1", "5", "9"},
+			[]EmbeddingOption{WithEmbeddingBatchSize(2)},
+			3,
+			[][]float64{{3}, {1}, {4}, {1}, {5}, {9}},
+		},
+		{
+			"short last batch",
+			[]string{"3", "1", "4", "1", "5", "9", "2"},
+			[]EmbeddingOption{WithEmbeddingBatchSize(3)},
+			3,
+			[][]float64{{3}, {1}, {4}, {1}, {5}, {9}, {2}},
+		},
+		{
+			"size zero sends one batch",
+			[]string{"3", "1", "4", "1", "5", "9", "2"},
+			[]EmbeddingOption{WithEmbeddingBatchSize(0)},
+			1,
+			[][]float64{{3}, {1}, {4}, {1}, {5}, {9}, {2}},
+		},
+		{
+			"sequential",
+			[]string{"3", "1", "4", "1", "5"},
+			[]EmbeddingOption{WithEmbeddingBatchSize(1), WithEmbeddingConcurrency(0)},
+			5,
+			[][]float64{{3}, {1}, {4}, {1}, {5}},
+		},
+		{
+			"more workers than batches",
+			[]string{"3", "1", "4", "1"},
+			[]EmbeddingOption{WithEmbeddingBatchSize(2), WithEmbeddingConcurrency(16)},
+			2,
+			[][]float64{{3}, {1}, {4}, {1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var batches [][]string
+			embed := func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+				mu.Lock()
+				batches = append(batches, texts)
+				mu.Unlock()
+				return fakeEmbed(nil)(ctx, name, texts)
+			}
+
+			resp, err := batchEmbed(context.Background(), "emb", tt.texts, tt.opts, embed)
+			if err != nil {
+				t.Fatalf("batchEmbed: %v", err)
+			}
+			if len(batches) != tt.wantBatches {
+				t.Errorf("%d sub-batches, want %d", len(batches), tt.wantBatches)
+			}
+			embeddings, err := resp.Embeddings()
+			if err != nil {
+				t.Fatalf("Embeddings: %v", err)
+			}
+			if !reflect.DeepEqual(embeddings, tt.want) {
+				t.Errorf("embeddings = %v, want %v in input order", embeddings, tt.want)
+			}
+			if !resp.Success || int(resp.TokensUsed) != len(tt.texts) {
+				t.Errorf("response success %v tokens %d, want true and %d", resp.Success, resp.TokensUsed, len(tt.texts))
+			}
+		})
+	}
+}
+
+func TestBatchEmbedConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	release := make(chan struct{})
+	embed := func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		<-release
+		running.Add(-1)
+		return fakeEmbed(nil)(ctx, name, texts)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := batchEmbed(context.Background(), "emb", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}, []EmbeddingOption{
+			WithEmbeddingBatchSize(1), WithEmbeddingConcurrency(3),
+		}, embed)
+		done <- err
+	}()
+	// Give a fourth sub-batch the chance to start before releasing them
+	for running.Load() < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("%d sub-batches ran at once, want at most 3", p)
+	}
+}
+
+func TestBatchEmbedPartialFailure(t *testing.T) {
+	texts := []string{"0", "1", "2", "3", "4", "5"}
+	resp, err := batchEmbed(context.Background(), "emb", texts, []EmbeddingOption{WithEmbeddingBatchSize(2)},
+		fakeEmbed(map[string]error{"2": errors.New("rate limited")}))
+	if err != nil {
+		t.Fatalf("batchEmbed: %v", err)
+	}
+	if resp.Success || resp.Message != "2 of 6 texts failed, first at 2: rate limited" {
+		t.Errorf("response success %v message %q, want the failed sub-batch reported", resp.Success, resp.Message)
+	}
+	for i, result := range resp.Results {
+		if failed := i == 2 || i == 3; failed != (result.Err != nil) {
+			t.Errorf("text %d: error %v, want failed %v", i, result.Err, failed)
+		}
+	}
+	if _, err := resp.Embeddings(); err == nil || err.Error() != "text 2: rate limited" {
+		t.Errorf("Embeddings error = %v, want text 2: rate limited", err)
+	}
+}
+
+func TestEmbedSubBatch(t *testing.T) {
+	vec := []float64{1}
+	tests := []struct {
+		name    string
+		resp    *LLMBatchEmbeddingResponse
+		wantErr []string
+	}{
+		{
+			"ok",
+			&LLMBatchEmbeddingResponse{Success: true, Results: []EmbeddingResult{{Embedding: vec}, {Embedding: vec}}},
+			[]string{"", ""},
+		},
+		{
+			"per-text error",
+			&LLMBatchEmbeddingResponse{Success: false, Message: "one failed", Results: []EmbeddingResult{{Embedding: vec}, {Err: errors.New("too long")}}},
+			[]string{"", "too long"},
+		},
+		{
+			"texts without embedding share the batch error",
+			&LLMBatchEmbeddingResponse{Success: false, Message: "quota", Results: []EmbeddingResult{{Embedding: vec}, {}}},
+			[]string{"", "quota"},
+		},
+		{
+			"failed batch without results",
+			&LLMBatchEmbeddingResponse{Success: false, Message: "model not found"},
+			[]string{"model not found", "model not found"},
+		},
+		{
+			"failed batch without message",
+			&LLMBatchEmbeddingResponse{Success: false},
+			[]string{"batch embedding llm failed", "batch embedding llm failed"},
+		},
+		{
+			"result count mismatch",
+			&LLMBatchEmbeddingResponse{Success: true, Results: []EmbeddingResult{{Embedding: vec}}},
+			[]string{"got 1 results for 2 texts", "got 1 results for 2 texts"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := make([]EmbeddingResult, 2)
+			embedSubBatch(context.Background(), "emb", []string{"a", "b"}, func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+				return tt.resp, nil
+			}, results)
+			for i, want := range tt.wantErr {
+				got := ""
+				if results[i].Err != nil {
+					got = results[i].Err.Error()
+				}
+				if (want == "") != (got == "") || !strings.Contains(got, want) {
+					t.Errorf("text %d error = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchEmbedContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	embed := func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+		if calls.Add(1) == 2 {
+			cancel()
+		}
+		return fakeEmbed(nil)(ctx, name, texts)
+	}
+
+	_, err := batchEmbed(ctx, "emb", []string{"0", "1", "2", "3", "4", "5", "6", "7"}, []EmbeddingOption{
+		WithEmbeddingBatchSize(1), WithEmbeddingConcurrency(1),
+	}, embed)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("batchEmbed error = %v, want context.Canceled", err)
+	}
+	// The dispatcher may already be offering the next sub-batch when ctx ends
+	if n := calls.Load(); n > 3 {
+		t.Errorf("%d sub-batches dispatched after cancel, want dispatch to stop", n)
+	}
+	if err == nil || !strings.HasPrefix(err.Error(), fmt.Sprintf("batch embedding llm failed: %v", context.Canceled)) {
+		t.Errorf("batchEmbed error = %v, want the batch embedding prefix", err)
+	}
+}
@@ -24,14 +24,40 @@ typedef ProtoBuffer (*datasource_execute_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*datasource_insert_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*datasource_ping_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*datasource_close_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*datasource_begin_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*datasource_commit_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*datasource_rollback_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*datasource_list_tables_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*datasource_describe_table_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*kafka_publish_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*kafka_commit_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*mongo_find_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*mongo_insert_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*mongo_update_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*mongo_delete_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*llm_create_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*llm_generate_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*llm_chat_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*llm_embedding_proto_fn)(const uint8_t*, size_t);
+typedef ProtoBuffer (*llm_batch_embedding_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*llm_ping_proto_fn)(const uint8_t*, size_t);
 typedef ProtoBuffer (*llm_close_proto_fn)(const uint8_t*, size_t);
 typedef void (*proto_buffer_free_fn)(ProtoBuffer);
 
+// Streaming entry points report each message through a callback. Returning
+// non-zero from the callback asks the library to stop early.
+typedef int (*stream_chunk_fn)(uintptr_t, const uint8_t*, size_t);
+typedef ProtoBuffer (*llm_stream_proto_fn)(const uint8_t*, size_t, stream_chunk_fn, uintptr_t);
+typedef ProtoBuffer (*datasource_query_stream_proto_fn)(const uint8_t*, size_t, stream_chunk_fn, uintptr_t);
+typedef ProtoBuffer (*kafka_subscribe_proto_fn)(const uint8_t*, size_t, stream_chunk_fn, uintptr_t);
+
+// Implemented in Go (ffi_stream.go)
+extern int operrouterStreamChunk(uintptr_t, uint8_t*, size_t);
+
+static int has_ffi_symbol(void* handle, const char* name) {
+    return dlsym(handle, name) != NULL;
+}
+
 // Helper functions to call FFI with dynamic loading
 static ProtoBuffer call_ping_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
     ping_proto_fn fn = (ping_proto_fn)dlsym(handle, "ping_proto");
@@ -75,6 +101,12 @@ static ProtoBuffer call_datasource_query_proto(void* handle, const uint8_t* inpu
     return fn(input_ptr, input_len);
 }
 
+static ProtoBuffer call_datasource_query_stream_proto(void* handle, const uint8_t* input_ptr, size_t input_len, uintptr_t stream) {
+    datasource_query_stream_proto_fn fn = (datasource_query_stream_proto_fn)dlsym(handle, "datasource_query_stream_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len, (stream_chunk_fn)operrouterStreamChunk, stream);
+}
+
 static ProtoBuffer call_datasource_execute_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
     datasource_execute_proto_fn fn = (datasource_execute_proto_fn)dlsym(handle, "datasource_execute_proto");
     if (!fn) return (ProtoBuffer){NULL, 0};
@@ -99,6 +131,80 @@ static ProtoBuffer call_datasource_close_proto(void* handle, const uint8_t* inpu
     return fn(input_ptr, input_len);
 }
 
+static ProtoBuffer call_datasource_begin_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    datasource_begin_proto_fn fn = (datasource_begin_proto_fn)dlsym(handle, "datasource_begin_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_datasource_commit_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    datasource_commit_proto_fn fn = (datasource_commit_proto_fn)dlsym(handle, "datasource_commit_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_datasource_rollback_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    datasource_rollback_proto_fn fn = (datasource_rollback_proto_fn)dlsym(handle, "datasource_rollback_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_datasource_list_tables_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    datasource_list_tables_proto_fn fn = (datasource_list_tables_proto_fn)dlsym(handle, "datasource_list_tables_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_datasource_describe_table_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    datasource_describe_table_proto_fn fn = (datasource_describe_table_proto_fn)dlsym(handle, "datasource_describe_table_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+// Kafka helper functions
+static ProtoBuffer call_kafka_publish_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    kafka_publish_proto_fn fn = (kafka_publish_proto_fn)dlsym(handle, "kafka_publish_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_kafka_subscribe_proto(void* handle, const uint8_t* input_ptr, size_t input_len, uintptr_t stream) {
+    kafka_subscribe_proto_fn fn = (kafka_subscribe_proto_fn)dlsym(handle, "kafka_subscribe_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len, (stream_chunk_fn)operrouterStreamChunk, stream);
+}
+
+static ProtoBuffer call_kafka_commit_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    kafka_commit_proto_fn fn = (kafka_commit_proto_fn)dlsym(handle, "kafka_commit_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+// MongoDB helper functions
+static ProtoBuffer call_mongo_find_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    mongo_find_proto_fn fn = (mongo_find_proto_fn)dlsym(handle, "mongo_find_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_mongo_insert_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    mongo_insert_proto_fn fn = (mongo_insert_proto_fn)dlsym(handle, "mongo_insert_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_mongo_update_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    mongo_update_proto_fn fn = (mongo_update_proto_fn)dlsym(handle, "mongo_update_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_mongo_delete_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    mongo_delete_proto_fn fn = (mongo_delete_proto_fn)dlsym(handle, "mongo_delete_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
 // LLM helper functions
 static ProtoBuffer call_llm_create_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
     llm_create_proto_fn fn = (llm_create_proto_fn)dlsym(handle, "llm_create_proto");
@@ -124,6 +230,18 @@ static ProtoBuffer call_llm_embedding_proto(void* handle, const uint8_t* input_p
     return fn(input_ptr, input_len);
 }
 
+static ProtoBuffer call_llm_batch_embedding_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
+    llm_batch_embedding_proto_fn fn = (llm_batch_embedding_proto_fn)dlsym(handle, "llm_batch_embedding_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len);
+}
+
+static ProtoBuffer call_llm_stream_proto(void* handle, const uint8_t* input_ptr, size_t input_len, uintptr_t stream) {
+    llm_stream_proto_fn fn = (llm_stream_proto_fn)dlsym(handle, "llm_stream_proto");
+    if (!fn) return (ProtoBuffer){NULL, 0};
+    return fn(input_ptr, input_len, (stream_chunk_fn)operrouterStreamChunk, stream);
+}
+
 static ProtoBuffer call_llm_ping_proto(void* handle, const uint8_t* input_ptr, size_t input_len) {
     llm_ping_proto_fn fn = (llm_ping_proto_fn)dlsym(handle, "llm_ping_proto");
     if (!fn) return (ProtoBuffer){NULL, 0};
@@ -139,7 +257,12 @@ static ProtoBuffer call_llm_close_proto(void* handle, const uint8_t* input_ptr,
 import "C"
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"runtime/cgo"
+	"sync"
+	"time"
 	"unsafe"
 
 	pb "github.com/operrouter/go-operrouter/gen/proto"
@@ -148,13 +271,61 @@ import (
 
 // FFIClient implements the Client interface using FFI (cgo)
 type FFIClient struct {
-	handle unsafe.Pointer
-	path   string
+	handle  unsafe.Pointer
+	path    string
+	timeout time.Duration
+	opts    *clientOptions
+
+	// mu guards handle: every library call holds the read lock until it
+	// returns, and Close takes the write lock so it never unloads the
+	// library underneath a call
+	mu sync.RWMutex
+
+	// state guards closed and streams. It is never held across a library
+	// call, so Close can refuse new calls and stop open streams before it
+	// waits for the write lock.
+	state   sync.Mutex
+	closed  bool
+	streams map[*ffiStream]struct{}
+}
+
+// errFFIClosed is returned by calls made after Close
+var errFFIClosed = errors.New("operrouter: FFI client is closed")
+
+// acquire takes the read lock for one library call. It fails once the client
+// is closed; otherwise the caller must release the lock with c.mu.RUnlock.
+func (c *FFIClient) acquire() error {
+	// Fail before RLock: once Close waits for the write lock, RLock would
+	// block until every open call has returned
+	if c.isClosed() {
+		return errFFIClosed
+	}
+	c.mu.RLock()
+	if c.isClosed() {
+		c.mu.RUnlock()
+		return errFFIClosed
+	}
+	return nil
 }
 
+// isClosed reports whether Close has been called
+func (c *FFIClient) isClosed() bool {
+	c.state.Lock()
+	defer c.state.Unlock()
+	return c.closed
+}
+
+// ffiSupportedOptions lists the ClientOptions honored by NewFFI
+var ffiSupportedOptions = []string{"WithTimeout", "WithLogger"}
+
 // NewFFI creates a new FFI client by loading the shared library
 // Example: client, err := operrouter.NewFFI("/path/to/liboperrouter_core_ffi.so")
 func NewFFI(libraryPath string, opts ...ClientOption) (*FFIClient, error) {
+	o, err := newClientOptions("FFI", ffiSupportedOptions, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	cPath := C.CString(libraryPath)
 	defer C.free(unsafe.Pointer(cPath))
 
@@ -166,30 +337,85 @@ func NewFFI(libraryPath string, opts ...ClientOption) (*FFIClient, error) {
 	}
 
 	client := &FFIClient{
-		handle: handle,
-		path:   libraryPath,
-	}
-
-	// Apply options
-	for _, opt := range opts {
-		opt(client)
+		handle:  handle,
+		path:    libraryPath,
+		timeout: o.timeout,
+		opts:    o,
 	}
 
 	return client, nil
 }
 
-// callFFI is a helper to call FFI functions with protobuf marshaling/unmarshaling
+// callFFI is a helper to call FFI functions with protobuf marshaling/unmarshaling.
+// The library call itself cannot be interrupted. When ctx ends first, callFFI
+// abandons the calls listed in idempotentFFIRequests and returns the context
+// error; for any other call it waits for the result, so a commit or write
+// that went through is never reported as cancelled.
 func (c *FFIClient) callFFI(
+	ctx context.Context,
 	callFunc func(unsafe.Pointer, *C.uint8_t, C.size_t) C.ProtoBuffer,
 	req proto.Message,
 	resp proto.Message,
 ) error {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Marshal request
 	reqBytes, err := proto.Marshal(req)
 	if err != nil {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	type result struct {
+		data []byte
+		err  error
+	}
+	done := make(chan result, 1)
+	if err := c.acquire(); err != nil {
+		return err
+	}
+	go func() {
+		defer c.mu.RUnlock()
+		data, err := c.invoke(callFunc, reqBytes)
+		done <- result{data, err}
+	}()
+
+	var r result
+	if idempotentFFIRequests[proto.MessageName(req)] {
+		select {
+		case <-ctx.Done():
+			c.opts.logger.DebugContext(ctx, "operrouter call abandoned",
+				"backend", "ffi", "request", proto.MessageName(req), "error", ctx.Err())
+			return ctx.Err()
+		case r = <-done:
+		}
+	} else {
+		r = <-done
+	}
+	if r.err != nil {
+		return r.err
+	}
+	respBytes := r.data
+
+	// Unmarshal response
+	if len(respBytes) > 0 {
+		if err := proto.Unmarshal(respBytes, resp); err != nil {
+			c.opts.logger.DebugContext(ctx, "operrouter call failed",
+				"backend", "ffi", "request", proto.MessageName(req), "error", err)
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// invoke runs one library call and copies its result into Go memory.
+// The caller holds the read lock.
+func (c *FFIClient) invoke(callFunc func(unsafe.Pointer, *C.uint8_t, C.size_t) C.ProtoBuffer, reqBytes []byte) ([]byte, error) {
 	var inputPtr *C.uint8_t
 	var inputLen C.size_t
 
@@ -207,7 +433,7 @@ func (c *FFIClient) callFFI(
 
 	// Check for null response
 	if outputBuf.data == nil && outputBuf.len > 0 {
-		return fmt.Errorf("FFI call returned null pointer")
+		return nil, fmt.Errorf("FFI call returned null pointer")
 	}
 
 	// Copy response data before freeing
@@ -219,22 +445,112 @@ func (c *FFIClient) callFFI(
 	// Free the buffer
 	C.call_proto_buffer_free(c.handle, outputBuf)
 
-	// Unmarshal response
-	if len(respBytes) > 0 {
-		if err := proto.Unmarshal(respBytes, resp); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+	return respBytes, nil
+}
+
+// requireSymbol fails when the library does not export symbol, so optional
+// entry points fail fast instead of returning an empty response
+func (c *FFIClient) requireSymbol(symbol string) error {
+	if err := c.acquire(); err != nil {
+		return err
 	}
+	defer c.mu.RUnlock()
+	return c.hasSymbol(symbol)
+}
 
+// hasSymbol is requireSymbol for callers that already hold the read lock
+func (c *FFIClient) hasSymbol(symbol string) error {
+	cSymbol := C.CString(symbol)
+	defer C.free(unsafe.Pointer(cSymbol))
+	if C.has_ffi_symbol(c.handle, cSymbol) == 0 {
+		return fmt.Errorf("FFI library %s does not export %s", c.path, symbol)
+	}
 	return nil
 }
 
+// openStream starts a streaming library call. symbol names the entry point so
+// a library without streaming support fails fast instead of ending silently.
+func (c *FFIClient) openStream(
+	ctx context.Context,
+	symbol string,
+	callFunc func(unsafe.Pointer, *C.uint8_t, C.size_t, C.uintptr_t) C.ProtoBuffer,
+	req proto.Message,
+) (*ffiStream, error) {
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// The read lock is held until the library call returns
+	if err := c.acquire(); err != nil {
+		return nil, err
+	}
+	if err := c.hasSymbol(symbol); err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	s := &ffiStream{
+		ctx:      ctx,
+		messages: make(chan []byte),
+		stop:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+
+	// Register the stream so that Close can stop it
+	c.state.Lock()
+	if c.closed {
+		c.state.Unlock()
+		c.mu.RUnlock()
+		return nil, errFFIClosed
+	}
+	if c.streams == nil {
+		c.streams = make(map[*ffiStream]struct{})
+	}
+	c.streams[s] = struct{}{}
+	c.state.Unlock()
+
+	// Stop the library as soon as the caller gives up
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.close()
+		case <-s.finished:
+		}
+	}()
+
+	go func() {
+		defer c.mu.RUnlock()
+		defer close(s.finished)
+		defer func() {
+			c.state.Lock()
+			delete(c.streams, s)
+			c.state.Unlock()
+		}()
+
+		handle := cgo.NewHandle(s)
+		defer handle.Delete()
+
+		var inputPtr *C.uint8_t
+		if len(reqBytes) > 0 {
+			inputPtr = (*C.uint8_t)(unsafe.Pointer(&reqBytes[0]))
+		}
+		outputBuf := callFunc(c.handle, inputPtr, C.size_t(len(reqBytes)), C.uintptr_t(handle))
+		if outputBuf.len > 0 && outputBuf.data != nil {
+			s.final = C.GoBytes(unsafe.Pointer(outputBuf.data), C.int(outputBuf.len))
+		}
+		C.call_proto_buffer_free(c.handle, outputBuf)
+	}()
+
+	return s, nil
+}
+
 // Ping checks the service health
 func (c *FFIClient) Ping(ctx context.Context) (*PingResponse, error) {
 	req := &pb.PingRequest{}
 	resp := &pb.PingResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_ping_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("ping failed: %w", err)
@@ -253,7 +569,7 @@ func (c *FFIClient) ValidateConfig(ctx context.Context, tomlContent string) (*Va
 	}
 	resp := &pb.ValidateConfigResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_validate_config_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("validate config failed: %w", err)
@@ -272,7 +588,7 @@ func (c *FFIClient) LoadConfig(ctx context.Context, configPath string) (*LoadCon
 	}
 	resp := &pb.LoadConfigResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_load_config_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("load config failed: %w", err)
@@ -290,7 +606,7 @@ func (c *FFIClient) GetMetadata(ctx context.Context) (*MetadataResponse, error)
 	req := &pb.GetMetadataRequest{}
 	resp := &pb.GetMetadataResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_get_metadata_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("get metadata failed: %w", err)
@@ -307,12 +623,28 @@ func (c *FFIClient) GetMetadata(ctx context.Context) (*MetadataResponse, error)
 	}, nil
 }
 
-// Close closes the FFI library handle
+// Close closes the FFI library handle once in-flight calls have returned.
+// Calls fail as soon as Close starts. Open streams are asked to stop and end
+// with an error; a stream's library call returns at its next callback.
 func (c *FFIClient) Close() error {
-	if c.handle != nil {
-		C.dlclose(c.handle)
-		c.handle = nil
+	c.state.Lock()
+	if c.closed {
+		c.state.Unlock()
+		return nil
+	}
+	c.closed = true
+	streams := c.streams
+	c.streams = nil
+	c.state.Unlock()
+
+	for s := range streams {
+		s.abort(errFFIClosed)
 	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	C.dlclose(c.handle)
+	c.handle = nil
 	return nil
 }
 
@@ -320,65 +652,22 @@ func (c *FFIClient) Close() error {
 
 // CreateDataSource creates a new DataSource connection
 func (c *FFIClient) CreateDataSource(ctx context.Context, name string, config map[string]interface{}) (*DataSourceResponse, error) {
-	// Build connection URL from config
-	url := ""
-	extra := make(map[string]string)
-
-	// Extract driver type
-	driver := ""
-	if d, ok := config["driver"].(string); ok {
-		driver = d
-		// Build URL based on driver
-		switch driver {
-		case "postgres", "postgresql":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			database := config["database"].(string)
-			url = fmt.Sprintf("postgres://%s:%d/%s", host, port, database)
-			if username, ok := config["username"].(string); ok {
-				extra["username"] = username
-			}
-			if password, ok := config["password"].(string); ok {
-				extra["password"] = password
-			}
-		case "mysql":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			database := config["database"].(string)
-			url = fmt.Sprintf("mysql://%s:%d/%s", host, port, database)
-		case "redis":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			url = fmt.Sprintf("redis://%s:%d", host, port)
-		case "mongodb", "mongo":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			url = fmt.Sprintf("mongodb://%s:%d", host, port)
-		case "kafka":
-			if brokers, ok := config["brokers"].(string); ok {
-				url = brokers
-			}
-		}
+	dsConfig, err := ParseDataSourceConfig(config)
+	if err != nil {
+		return nil, err
 	}
-
-	// Copy remaining config to extra
-	for k, v := range config {
-		if k != "driver" && k != "host" && k != "port" && k != "database" && k != "username" && k != "password" && k != "brokers" {
-			extra[k] = fmt.Sprintf("%v", v)
-		}
+	configProto, err := dataSourceConfigToProto(dsConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	req := &pb.CreateDataSourceRequest{
-		Name: name,
-		Config: &pb.DataSourceConfig{
-			Type:  mapDataSourceType(driver),
-			Url:   url,
-			Extra: extra,
-		},
+		Name:   name,
+		Config: configProto,
 	}
 	resp := &pb.CreateDataSourceResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_datasource_create_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("datasource create failed: %w", err)
@@ -391,70 +680,138 @@ func (c *FFIClient) CreateDataSource(ctx context.Context, name string, config ma
 }
 
 // QueryDataSource executes a read query on a DataSource
-func (c *FFIClient) QueryDataSource(ctx context.Context, name string, query string) (*DataSourceQueryResponse, error) {
+func (c *FFIClient) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceQueryResponse, error) {
+	return c.query(ctx, name, "", query, args)
+}
+
+// query runs a read query, inside transaction txID when it is set
+func (c *FFIClient) query(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceQueryResponse, error) {
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("datasource query failed: %w", err)
+	}
+
 	req := &pb.QueryDataSourceRequest{
-		Name:  name,
-		Query: query,
+		Name:          name,
+		Query:         query,
+		Args:          positional,
+		NamedArgs:     named,
+		TransactionId: txID,
 	}
 	resp := &pb.QueryDataSourceResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_datasource_query_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("datasource query failed: %w", err)
 	}
 
-	// Simplified: Convert proto rows to map format (implement proper parsing based on your needs)
-	rows := make([]map[string]interface{}, 0)
-
 	return &DataSourceQueryResponse{
 		Success: resp.Success,
-		Rows:    rows,
+		Rows:    rowsFromProto(resp.Rows),
 		Message: resp.Error,
+		Columns: resp.Columns,
 	}, nil
 }
 
+// StreamQueryDataSource executes a read query and streams its rows in pages
+func (c *FFIClient) StreamQueryDataSource(ctx context.Context, name string, pageSize uint32, query string, args ...interface{}) (*RowCursor, error) {
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("datasource query stream failed: %w", err)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	req := &pb.StreamQueryDataSourceRequest{
+		Name:      name,
+		Query:     query,
+		Args:      positional,
+		NamedArgs: named,
+		PageSize:  pageSize,
+	}
+
+	stream, err := c.openStream(ctx, "datasource_query_stream_proto", func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t, s C.uintptr_t) C.ProtoBuffer {
+		return C.call_datasource_query_stream_proto(h, ptr, len, s)
+	}, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("datasource query stream failed: %w", err)
+	}
+
+	recv := func() (*rowPage, error) {
+		resp := &pb.StreamQueryDataSourceResponse{}
+		if err := stream.next(resp); err != nil {
+			return nil, err
+		}
+		return &rowPage{
+			Success: resp.Success,
+			Items:   rowsFromProto(resp.Rows),
+			Done:    resp.Done,
+			Error:   resp.Error,
+		}, nil
+	}
+
+	return newRowCursor(ctx, func() {
+		cancel()
+		stream.close()
+	}, recv), nil
+}
+
 // ExecuteDataSource executes a write operation on a DataSource
-func (c *FFIClient) ExecuteDataSource(ctx context.Context, name string, query string) (*DataSourceResponse, error) {
+func (c *FFIClient) ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceExecuteResponse, error) {
+	return c.execute(ctx, name, "", query, args)
+}
+
+// execute runs a write statement, inside transaction txID when it is set
+func (c *FFIClient) execute(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceExecuteResponse, error) {
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("datasource execute failed: %w", err)
+	}
+
 	req := &pb.ExecuteDataSourceRequest{
-		Name:  name,
-		Query: query,
+		Name:          name,
+		Query:         query,
+		Args:          positional,
+		NamedArgs:     named,
+		TransactionId: txID,
 	}
 	resp := &pb.ExecuteDataSourceResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_datasource_execute_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("datasource execute failed: %w", err)
 	}
 
-	return &DataSourceResponse{
-		Success: resp.Success,
-		Message: resp.Error,
+	return &DataSourceExecuteResponse{
+		Success:      resp.Success,
+		AffectedRows: resp.AffectedRows,
+		LastInsertID: resp.LastInsertId,
+		Message:      resp.Error,
+		Rows:         rowsFromProto(resp.Rows),
 	}, nil
 }
 
 // InsertDataSource inserts data into a DataSource
 func (c *FFIClient) InsertDataSource(ctx context.Context, name string, data map[string]interface{}) (*DataSourceResponse, error) {
-	// Convert map to proto Row
-	columns := make(map[string]*pb.Value)
-	for key, val := range data {
-		// Simplified: convert to string value
-		columns[key] = &pb.Value{
-			Value: &pb.Value_StringValue{StringValue: fmt.Sprintf("%v", val)},
-		}
+	row, err := MapToRow(data)
+	if err != nil {
+		return nil, fmt.Errorf("datasource insert failed: %w", err)
 	}
 
 	req := &pb.InsertDataSourceRequest{
 		Name:  name,
 		Table: "", // Table name could be extracted from data or passed separately
-		Data: &pb.Row{
-			Columns: columns,
-		},
+		Data:  row,
 	}
 	resp := &pb.InsertDataSourceResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_datasource_insert_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("datasource insert failed: %w", err)
@@ -466,6 +823,86 @@ func (c *FFIClient) InsertDataSource(ctx context.Context, name string, data map[
 	}, nil
 }
 
+// BulkInsertDataSource inserts rows into a table or collection in batches
+func (c *FFIClient) BulkInsertDataSource(ctx context.Context, name string, table string, rows []map[string]interface{}, opts ...InsertOption) (*DataSourceInsertResponse, error) {
+	return bulkInsert(ctx, table, rows, opts, func(ctx context.Context, table string, batch []map[string]interface{}) (*DataSourceInsertResponse, error) {
+		return c.insertRows(ctx, name, "", table, batch)
+	})
+}
+
+// insertRows inserts one batch of rows, inside transaction txID when it is set
+func (c *FFIClient) insertRows(ctx context.Context, name, txID, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error) {
+	req, err := insertRequest(name, txID, table, rows)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.InsertDataSourceResponse{}
+
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_datasource_insert_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("datasource insert failed: %w", err)
+	}
+
+	return &DataSourceInsertResponse{
+		Success:      resp.Success,
+		InsertedRows: resp.InsertedRows,
+		Message:      resp.Error,
+	}, nil
+}
+
+// BeginTx starts a transaction on a DataSource
+func (c *FFIClient) BeginTx(ctx context.Context, name string, opts *sql.TxOptions) (*Tx, error) {
+	if err := c.requireSymbol("datasource_begin_proto"); err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	isolation, readOnly, err := isolationLevel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.BeginTransactionRequest{
+		Name:      name,
+		Isolation: isolation,
+		ReadOnly:  readOnly,
+	}
+	resp := &pb.BeginTransactionResponse{}
+
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_datasource_begin_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("begin transaction failed: %s", resp.Error)
+	}
+
+	return newTx(ctx, c, c.opts.logger, name, resp.TransactionId), nil
+}
+
+// endTx commits or rolls back transaction txID
+func (c *FFIClient) endTx(ctx context.Context, name, txID string, commit bool) error {
+	if commit {
+		req := &pb.CommitTransactionRequest{Name: name, TransactionId: txID}
+		resp := &pb.CommitTransactionResponse{}
+		if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+			return C.call_datasource_commit_proto(h, ptr, len)
+		}, req, resp); err != nil {
+			return fmt.Errorf("commit transaction failed: %w", err)
+		}
+		return txEndError(true, resp.Success, resp.Error)
+	}
+
+	req := &pb.RollbackTransactionRequest{Name: name, TransactionId: txID}
+	resp := &pb.RollbackTransactionResponse{}
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_datasource_rollback_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return fmt.Errorf("rollback transaction failed: %w", err)
+	}
+	return txEndError(false, resp.Success, resp.Error)
+}
+
 // PingDataSource checks if a DataSource is alive
 func (c *FFIClient) PingDataSource(ctx context.Context, name string) (*DataSourceResponse, error) {
 	req := &pb.PingDataSourceRequest{
@@ -473,7 +910,7 @@ func (c *FFIClient) PingDataSource(ctx context.Context, name string) (*DataSourc
 	}
 	resp := &pb.PingDataSourceResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_datasource_ping_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("datasource ping failed: %w", err)
@@ -492,7 +929,7 @@ func (c *FFIClient) CloseDataSource(ctx context.Context, name string) (*DataSour
 	}
 	resp := &pb.CloseDataSourceResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_datasource_close_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("datasource close failed: %w", err)
@@ -504,32 +941,214 @@ func (c *FFIClient) CloseDataSource(ctx context.Context, name string) (*DataSour
 	}, nil
 }
 
-// LLM operations
+// ListTables lists the tables, views or collections of a DataSource
+func (c *FFIClient) ListTables(ctx context.Context, name string, schema string) (*ListTablesResponse, error) {
+	if err := c.requireSymbol("datasource_list_tables_proto"); err != nil {
+		return nil, fmt.Errorf("list tables failed: %w", err)
+	}
 
-// CreateLLM creates a new LLM client
-func (c *FFIClient) CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error) {
-	provider := ""
-	if p, ok := config["provider"].(string); ok {
-		provider = p
+	req := &pb.ListTablesRequest{
+		Name:   name,
+		Schema: schema,
+	}
+	resp := &pb.ListTablesResponse{}
+
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_datasource_list_tables_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("list tables failed: %w", err)
+	}
+	return listTablesResponse(resp), nil
+}
+
+// DescribeTable returns the columns of a table or collection
+func (c *FFIClient) DescribeTable(ctx context.Context, name string, schema string, table string) (*DescribeTableResponse, error) {
+	if err := c.requireSymbol("datasource_describe_table_proto"); err != nil {
+		return nil, fmt.Errorf("describe table failed: %w", err)
 	}
 
-	configProto := &pb.LLMConfig{
-		Provider: mapLLMProvider(provider),
+	req := &pb.DescribeTableRequest{
+		Name:   name,
+		Schema: schema,
+		Table:  table,
 	}
-	if model, ok := config["model"].(string); ok {
-		configProto.Model = model
+	resp := &pb.DescribeTableResponse{}
+
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_datasource_describe_table_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("describe table failed: %w", err)
 	}
-	if apiKey, ok := config["api_key"].(string); ok {
-		configProto.ApiKey = &apiKey
+	return describeTableResponse(resp), nil
+}
+
+// Kafka operations
+
+// PublishKafka publishes records to a Kafka DataSource in batches
+func (c *FFIClient) PublishKafka(ctx context.Context, name string, records []KafkaRecord, opts ...PublishOption) (*KafkaPublishResponse, error) {
+	if err := c.requireSymbol("kafka_publish_proto"); err != nil {
+		return nil, fmt.Errorf("kafka publish failed: %w", err)
+	}
+	return publishKafka(ctx, name, records, opts, func(ctx context.Context, req *pb.KafkaPublishRequest) (*pb.KafkaPublishResponse, error) {
+		resp := &pb.KafkaPublishResponse{}
+		if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+			return C.call_kafka_publish_proto(h, ptr, len)
+		}, req, resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	})
+}
+
+// SubscribeKafka consumes topics of a Kafka DataSource; the library reports
+// message batches through the stream callback
+func (c *FFIClient) SubscribeKafka(ctx context.Context, name string, opts KafkaSubscribeOptions) (*KafkaSubscription, error) {
+	req, err := opts.toProto(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.openStream(ctx, "kafka_subscribe_proto", func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t, s C.uintptr_t) C.ProtoBuffer {
+		return C.call_kafka_subscribe_proto(h, ptr, len, s)
+	}, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("kafka subscribe failed: %w", err)
+	}
+
+	recv := func() (*page[*KafkaMessage], error) {
+		resp := &pb.KafkaSubscribeResponse{}
+		if err := stream.next(resp); err != nil {
+			return nil, err
+		}
+		return &page[*KafkaMessage]{
+			Success: resp.Success,
+			Items:   kafkaMessagesFromProto(resp.Messages),
+			Error:   resp.Error,
+		}, nil
+	}
+	commit := func(ctx context.Context, req *pb.KafkaCommitRequest) (*pb.KafkaCommitResponse, error) {
+		if err := c.requireSymbol("kafka_commit_proto"); err != nil {
+			return nil, err
+		}
+		resp := &pb.KafkaCommitResponse{}
+		if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+			return C.call_kafka_commit_proto(h, ptr, len)
+		}, req, resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	return newKafkaSubscription(ctx, func() {
+		cancel()
+		stream.close()
+	}, name, opts.GroupID, recv, commit), nil
+}
+
+// MongoDB operations
+
+// FindMongo returns the documents of a MongoDB collection matching filter
+func (c *FFIClient) FindMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, opts *MongoFindOptions) (*MongoFindResponse, error) {
+	if err := c.requireSymbol("mongo_find_proto"); err != nil {
+		return nil, fmt.Errorf("find mongo failed: %w", err)
+	}
+	req, err := mongoFindRequest(name, collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.MongoFindResponse{}
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_mongo_find_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("find mongo failed: %w", err)
+	}
+	return mongoFindResponse(resp), nil
+}
+
+// InsertMongo inserts documents into a MongoDB collection
+func (c *FFIClient) InsertMongo(ctx context.Context, name string, collection string, documents []map[string]interface{}) (*MongoInsertResponse, error) {
+	if err := c.requireSymbol("mongo_insert_proto"); err != nil {
+		return nil, fmt.Errorf("insert mongo failed: %w", err)
+	}
+	req, err := mongoInsertRequest(name, collection, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.MongoInsertResponse{}
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_mongo_insert_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("insert mongo failed: %w", err)
+	}
+	return mongoInsertResponse(resp), nil
+}
+
+// UpdateMongo updates the documents of a MongoDB collection matching filter
+func (c *FFIClient) UpdateMongo(ctx context.Context, name string, collection string, filter, update map[string]interface{}, opts *MongoUpdateOptions) (*MongoUpdateResponse, error) {
+	if err := c.requireSymbol("mongo_update_proto"); err != nil {
+		return nil, fmt.Errorf("update mongo failed: %w", err)
+	}
+	req, err := mongoUpdateRequest(name, collection, filter, update, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.MongoUpdateResponse{}
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_mongo_update_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("update mongo failed: %w", err)
+	}
+	return mongoUpdateResponse(resp), nil
+}
+
+// DeleteMongo deletes the documents of a MongoDB collection matching filter
+func (c *FFIClient) DeleteMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, many bool) (*MongoDeleteResponse, error) {
+	if err := c.requireSymbol("mongo_delete_proto"); err != nil {
+		return nil, fmt.Errorf("delete mongo failed: %w", err)
+	}
+	req, err := mongoDeleteRequest(name, collection, filter, many)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.MongoDeleteResponse{}
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+		return C.call_mongo_delete_proto(h, ptr, len)
+	}, req, resp); err != nil {
+		return nil, fmt.Errorf("delete mongo failed: %w", err)
+	}
+	return &MongoDeleteResponse{
+		Success:      resp.Success,
+		DeletedCount: resp.DeletedCount,
+		Message:      resp.Error,
+	}, nil
+}
+
+// LLM operations
+
+// CreateLLM creates a new LLM client
+func (c *FFIClient) CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error) {
+	llmConfig, err := ParseLLMConfig(config)
+	if err != nil {
+		return nil, err
 	}
 
 	req := &pb.CreateLLMRequest{
 		Name:   name,
-		Config: configProto,
+		Config: llmConfig.toProto(),
 	}
 	resp := &pb.CreateLLMResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_llm_create_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("llm create failed: %w", err)
@@ -542,60 +1161,52 @@ func (c *FFIClient) CreateLLM(ctx context.Context, name string, config map[strin
 }
 
 // GenerateLLM generates text from a prompt
-func (c *FFIClient) GenerateLLM(ctx context.Context, name string, prompt string) (*LLMGenerateResponse, error) {
-	req := &pb.GenerateLLMRequest{
-		Name:   name,
-		Prompt: prompt,
+func (c *FFIClient) GenerateLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	req, err := generateRequest(name, prompt, opts)
+	if err != nil {
+		return nil, err
 	}
 	resp := &pb.GenerateLLMResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_llm_generate_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("llm generate failed: %w", err)
 	}
 
 	return &LLMGenerateResponse{
-		Success: resp.Success,
-		Text:    resp.Text,
-		Message: resp.Error,
+		Success:      resp.Success,
+		Text:         resp.Text,
+		Message:      resp.Error,
+		TokensUsed:   resp.GetTokensUsed(),
+		FinishReason: resp.GetFinishReason(),
+		Model:        resp.Model,
 	}, nil
 }
 
 // ChatLLM performs a chat conversation with message history
-func (c *FFIClient) ChatLLM(ctx context.Context, name string, messages []map[string]interface{}) (*LLMGenerateResponse, error) {
-	protoMessages := make([]*pb.LLMMessage, len(messages))
-	for i, msg := range messages {
-		role := ""
-		if r, ok := msg["role"].(string); ok {
-			role = r
-		}
-		content := ""
-		if c, ok := msg["content"].(string); ok {
-			content = c
-		}
-		protoMessages[i] = &pb.LLMMessage{
-			Role:    mapMessageRole(role),
-			Content: content,
-		}
+func (c *FFIClient) ChatLLM(ctx context.Context, name string, messages []ChatMessage, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	req, err := chatRequest(name, messages, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	req := &pb.ChatLLMRequest{
-		Name:     name,
-		Messages: protoMessages,
-	}
 	resp := &pb.ChatLLMResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_llm_chat_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("llm chat failed: %w", err)
 	}
 
 	return &LLMGenerateResponse{
-		Success: resp.Success,
-		Text:    resp.Text,
-		Message: resp.Error,
+		Success:      resp.Success,
+		Text:         resp.Text,
+		Message:      resp.Error,
+		TokensUsed:   resp.GetTokensUsed(),
+		FinishReason: resp.GetFinishReason(),
+		Model:        resp.Model,
+		ToolCalls:    toolCallsFromProto(resp.ToolCalls),
 	}, nil
 }
 
@@ -607,7 +1218,7 @@ func (c *FFIClient) EmbeddingLLM(ctx context.Context, name string, text string)
 	}
 	resp := &pb.EmbeddingLLMResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_llm_embedding_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("llm embedding failed: %w", err)
@@ -620,12 +1231,70 @@ func (c *FFIClient) EmbeddingLLM(ctx context.Context, name string, text string)
 	}
 
 	return &LLMEmbeddingResponse{
-		Success:   resp.Success,
-		Embedding: embedding,
-		Message:   resp.Error,
+		Success:    resp.Success,
+		Embedding:  embedding,
+		Message:    resp.Error,
+		Model:      resp.Model,
+		TokensUsed: resp.GetTokensUsed(),
 	}, nil
 }
 
+// BatchEmbeddingLLM generates embeddings for many texts, in sub-batches
+func (c *FFIClient) BatchEmbeddingLLM(ctx context.Context, name string, texts []string, opts ...EmbeddingOption) (*LLMBatchEmbeddingResponse, error) {
+	if err := c.requireSymbol("llm_batch_embedding_proto"); err != nil {
+		return nil, fmt.Errorf("batch embedding llm failed: %w", err)
+	}
+
+	return batchEmbed(ctx, name, texts, opts, func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+		req := &pb.BatchEmbeddingLLMRequest{
+			Name:  name,
+			Texts: texts,
+		}
+		resp := &pb.BatchEmbeddingLLMResponse{}
+
+		if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+			return C.call_llm_batch_embedding_proto(h, ptr, len)
+		}, req, resp); err != nil {
+			return nil, fmt.Errorf("batch embedding llm failed: %w", err)
+		}
+		return batchEmbeddingResponse(resp), nil
+	})
+}
+
+// StreamLLM generates text from a prompt, delivering it incrementally
+func (c *FFIClient) StreamLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMStream, error) {
+	req, err := streamRequest(name, prompt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.openStream(ctx, "llm_stream_proto", func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t, s C.uintptr_t) C.ProtoBuffer {
+		return C.call_llm_stream_proto(h, ptr, len, s)
+	}, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("llm stream failed: %w", err)
+	}
+
+	recv := func() (*pb.StreamLLMResponse, error) {
+		resp := &pb.StreamLLMResponse{}
+		if err := stream.next(resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	return newLLMStream(ctx, func() {
+		cancel()
+		stream.close()
+	}, recv), nil
+}
+
 // PingLLM checks if an LLM client is alive
 func (c *FFIClient) PingLLM(ctx context.Context, name string) (*LLMResponse, error) {
 	req := &pb.PingLLMRequest{
@@ -633,7 +1302,7 @@ func (c *FFIClient) PingLLM(ctx context.Context, name string) (*LLMResponse, err
 	}
 	resp := &pb.PingLLMResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_llm_ping_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("llm ping failed: %w", err)
@@ -652,7 +1321,7 @@ func (c *FFIClient) CloseLLM(ctx context.Context, name string) (*LLMResponse, er
 	}
 	resp := &pb.CloseLLMResponse{}
 
-	if err := c.callFFI(func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
+	if err := c.callFFI(ctx, func(h unsafe.Pointer, ptr *C.uint8_t, len C.size_t) C.ProtoBuffer {
 		return C.call_llm_close_proto(h, ptr, len)
 	}, req, resp); err != nil {
 		return nil, fmt.Errorf("llm close failed: %w", err)
@@ -0,0 +1,119 @@
+//go:build cgo
+// +build cgo
+
+package operrouter
+
+/*
+#include <stdint.h>
+#include <stddef.h>
+*/
+import "C"
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime/cgo"
+	"sync"
+	"unsafe"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ffiStream runs a callback-based library call in the background and hands
+// each message it reports to the consumer. The library blocks in the callback
+// until the consumer takes the message, so a slow reader applies backpressure.
+type ffiStream struct {
+	ctx      context.Context
+	messages chan []byte
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	// err is set by abort before stop closes and ends the stream instead of
+	// a clean io.EOF
+	errMu sync.Mutex
+	err   error
+
+	// final holds the buffer returned by the entry point once it finishes;
+	// a non-empty buffer is a terminal message, usually carrying an error
+	final    []byte
+	finished chan struct{}
+}
+
+// next unmarshals the next message into msg, returning io.EOF at the end
+func (s *ffiStream) next(msg proto.Message) error {
+	select {
+	case data := <-s.messages:
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return fmt.Errorf("failed to unmarshal stream message: %w", err)
+		}
+		return nil
+	case <-s.finished:
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.abortErr(); err != nil {
+			return err
+		}
+		if len(s.final) > 0 {
+			final := s.final
+			s.final = nil
+			if err := proto.Unmarshal(final, msg); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			return nil
+		}
+		return io.EOF
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// close asks the library to stop at its next callback
+func (s *ffiStream) close() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
+
+// abort stops the stream on behalf of the client; next then returns err
+func (s *ffiStream) abort(err error) {
+	s.errMu.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.errMu.Unlock()
+	s.close()
+}
+
+// abortErr returns the error passed to abort, if any
+func (s *ffiStream) abortErr() error {
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+	return s.err
+}
+
+// deliver hands one message to the consumer; it reports false once the
+// consumer has stopped listening
+func (s *ffiStream) deliver(data []byte) bool {
+	select {
+	case s.messages <- data:
+		return true
+	case <-s.stop:
+		return false
+	}
+}
+
+//export operrouterStreamChunk
+func operrouterStreamChunk(handle C.uintptr_t, data *C.uint8_t, length C.size_t) C.int {
+	s, ok := cgo.Handle(handle).Value().(*ffiStream)
+	if !ok {
+		return 1
+	}
+	var msg []byte
+	if length > 0 && data != nil {
+		msg = C.GoBytes(unsafe.Pointer(data), C.int(length))
+	}
+	if !s.deliver(msg) {
+		return 1
+	}
+	return 0
+}
@@ -2,12 +2,18 @@ package operrouter
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	pb "github.com/operrouter/go-operrouter/gen/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // GRPCClient implements the Client interface using gRPC
@@ -15,6 +21,13 @@ type GRPCClient struct {
 	conn    *grpc.ClientConn
 	service pb.OperRouterClient // Changed from OperRouterServiceClient
 	timeout time.Duration
+	opts    *clientOptions
+}
+
+// grpcSupportedOptions lists the ClientOptions honored by NewGRPC
+var grpcSupportedOptions = []string{
+	"WithTimeout", "WithHeader", "WithHeaders", "WithUserAgent",
+	"WithLogger", "WithRetryPolicy", "WithTLSConfig",
 }
 
 // NewGRPC creates a new gRPC client
@@ -26,7 +39,25 @@ func NewGRPC(address string, opts ...ClientOption) (*GRPCClient, error) {
 }
 
 // NewGRPCWithOptions creates a new gRPC client with custom dial options
+// WithTLSConfig, when given, takes precedence over credentials in dialOpts.
 func NewGRPCWithOptions(address string, dialOpts []grpc.DialOption, clientOpts ...ClientOption) (*GRPCClient, error) {
+	o, err := newClientOptions("gRPC", grpcSupportedOptions, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOpts = append([]grpc.DialOption{}, dialOpts...)
+	if o.tlsConfig != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(o.tlsConfig)))
+	}
+	if o.userAgent != "" {
+		dialOpts = append(dialOpts, grpc.WithUserAgent(o.userAgent))
+	}
+	dialOpts = append(dialOpts,
+		grpc.WithChainUnaryInterceptor(o.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(o.streamInterceptor),
+	)
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -38,24 +69,73 @@ func NewGRPCWithOptions(address string, dialOpts []grpc.DialOption, clientOpts .
 	client := &GRPCClient{
 		conn:    conn,
 		service: pb.NewOperRouterClient(conn), // Changed from NewOperRouterServiceClient
-		timeout: 5 * time.Second,
+		timeout: o.timeout,
+		opts:    o,
 	}
 
-	// Apply options
-	for _, opt := range clientOpts {
-		opt(client)
+	return client, nil
+}
+
+// outgoingContext attaches the configured metadata to ctx
+func (o *clientOptions) outgoingContext(ctx context.Context) context.Context {
+	if len(o.headers) == 0 {
+		return ctx
 	}
+	pairs := make([]string, 0, len(o.headers)*2)
+	for k, v := range o.headers {
+		pairs = append(pairs, strings.ToLower(k), v)
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
 
-	return client, nil
+// unaryInterceptor adds metadata, logging and retries to unary calls.
+// Only idempotent methods are retried; writes are left to gRPC's transparent
+// retry, which covers requests that never reached the server.
+func (o *clientOptions) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+	ctx = o.outgoingContext(ctx)
+
+	attempts := 1
+	if o.retry != nil {
+		attempts = o.retry.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		// Response headers prove the server received the call, so a call
+		// that got them is never retried
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(callOpts, grpc.Header(&header))...)
+		if err == nil {
+			return nil
+		}
+		retryable := status.Code(err) == codes.Unavailable && len(header) == 0 &&
+			idempotentGRPCMethods[method] && ctx.Err() == nil
+		if !retryable || attempt >= attempts {
+			o.logger.DebugContext(ctx, "operrouter call failed",
+				"backend", "grpc", "method", method, "attempt", attempt, "error", err)
+			return err
+		}
+		o.logger.WarnContext(ctx, "operrouter call failed, retrying",
+			"backend", "grpc", "method", method, "attempt", attempt, "error", err)
+		if sleepErr := o.retry.sleep(ctx, attempt); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// streamInterceptor adds metadata and logging to streaming calls
+func (o *clientOptions) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(o.outgoingContext(ctx), desc, cc, method, callOpts...)
+	if err != nil {
+		o.logger.DebugContext(ctx, "operrouter stream failed",
+			"backend", "grpc", "method", method, "error", err)
+	}
+	return stream, err
 }
 
 // Ping checks the service health
 func (c *GRPCClient) Ping(ctx context.Context) (*PingResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.PingRequest{}
 	resp, err := c.service.Ping(ctx, req)
@@ -71,11 +151,8 @@ func (c *GRPCClient) Ping(ctx context.Context) (*PingResponse, error) {
 
 // ValidateConfig validates operator configuration
 func (c *GRPCClient) ValidateConfig(ctx context.Context, tomlContent string) (*ValidateConfigResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.ValidateConfigRequest{
 		TomlContent: tomlContent,
@@ -93,11 +170,8 @@ func (c *GRPCClient) ValidateConfig(ctx context.Context, tomlContent string) (*V
 
 // LoadConfig loads operator configuration from file
 func (c *GRPCClient) LoadConfig(ctx context.Context, configPath string) (*LoadConfigResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.LoadConfigRequest{
 		ConfigPath: configPath,
@@ -116,11 +190,8 @@ func (c *GRPCClient) LoadConfig(ctx context.Context, configPath string) (*LoadCo
 
 // GetMetadata retrieves operator metadata
 func (c *GRPCClient) GetMetadata(ctx context.Context) (*MetadataResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.GetMetadataRequest{}
 	resp, err := c.service.GetMetadata(ctx, req)
@@ -181,79 +252,25 @@ func mapLLMProvider(provider string) pb.LLMProvider {
 	}
 }
 
-// Helper function to map role string to MessageRole enum
-func mapMessageRole(role string) pb.MessageRole {
-	switch role {
-	case "system":
-		return pb.MessageRole_MESSAGE_ROLE_SYSTEM
-	case "user":
-		return pb.MessageRole_MESSAGE_ROLE_USER
-	case "assistant":
-		return pb.MessageRole_MESSAGE_ROLE_ASSISTANT
-	default:
-		return pb.MessageRole_MESSAGE_ROLE_UNSPECIFIED
-	}
-}
-
 // DataSource operations
 
 // CreateDataSource creates a new DataSource connection
 func (c *GRPCClient) CreateDataSource(ctx context.Context, name string, config map[string]interface{}) (*DataSourceResponse, error) {
-	// Build connection URL from config
-	url := ""
-	extra := make(map[string]string)
-
-	// Extract driver type
-	driver := ""
-	if d, ok := config["driver"].(string); ok {
-		driver = d
-		// Build URL based on driver
-		switch driver {
-		case "postgres", "postgresql":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			database := config["database"].(string)
-			url = fmt.Sprintf("postgres://%s:%d/%s", host, port, database)
-			if username, ok := config["username"].(string); ok {
-				extra["username"] = username
-			}
-			if password, ok := config["password"].(string); ok {
-				extra["password"] = password
-			}
-		case "mysql":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			database := config["database"].(string)
-			url = fmt.Sprintf("mysql://%s:%d/%s", host, port, database)
-		case "redis":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			url = fmt.Sprintf("redis://%s:%d", host, port)
-		case "mongodb", "mongo":
-			host := config["host"].(string)
-			port := int(config["port"].(float64))
-			url = fmt.Sprintf("mongodb://%s:%d", host, port)
-		case "kafka":
-			if brokers, ok := config["brokers"].(string); ok {
-				url = brokers
-			}
-		}
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
-	// Copy remaining config to extra
-	for k, v := range config {
-		if k != "driver" && k != "host" && k != "port" && k != "database" && k != "username" && k != "password" && k != "brokers" {
-			extra[k] = fmt.Sprintf("%v", v)
-		}
+	dsConfig, err := ParseDataSourceConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	configProto, err := dataSourceConfigToProto(dsConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	req := &pb.CreateDataSourceRequest{
-		Name: name,
-		Config: &pb.DataSourceConfig{
-			Type:  mapDataSourceType(driver),
-			Url:   url,
-			Extra: extra,
-		},
+		Name:   name,
+		Config: configProto,
 	}
 
 	resp, err := c.service.CreateDataSource(ctx, req)
@@ -268,85 +285,129 @@ func (c *GRPCClient) CreateDataSource(ctx context.Context, name string, config m
 }
 
 // QueryDataSource executes a read query on a DataSource
-func (c *GRPCClient) QueryDataSource(ctx context.Context, name string, query string) (*DataSourceQueryResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
+func (c *GRPCClient) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceQueryResponse, error) {
+	return c.query(ctx, name, "", query, args)
+}
+
+// query runs a read query, inside transaction txID when it is set
+func (c *GRPCClient) query(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceQueryResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("query datasource failed: %w", err)
 	}
 
 	req := &pb.QueryDataSourceRequest{
-		Name:  name,
-		Query: query,
+		Name:          name,
+		Query:         query,
+		Args:          positional,
+		NamedArgs:     named,
+		TransactionId: txID,
 	}
 	resp, err := c.service.QueryDataSource(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("query datasource failed: %w", err)
 	}
 
-	// Convert proto rows to map format
-	rows := make([]map[string]interface{}, 0)
-	for _, protoRow := range resp.Rows {
-		rowMap := make(map[string]interface{})
-		for key, value := range protoRow.Columns {
-			rowMap[key] = value
-		}
-		rows = append(rows, rowMap)
-	}
-
 	return &DataSourceQueryResponse{
 		Success: resp.Success,
-		Rows:    rows,
+		Rows:    rowsFromProto(resp.Rows),
 		Message: resp.Error,
+		Columns: resp.Columns,
 	}, nil
 }
 
-// ExecuteDataSource executes a write operation on a DataSource
-func (c *GRPCClient) ExecuteDataSource(ctx context.Context, name string, query string) (*DataSourceResponse, error) {
+// StreamQueryDataSource executes a read query and streams its rows in pages
+func (c *GRPCClient) StreamQueryDataSource(ctx context.Context, name string, pageSize uint32, query string, args ...interface{}) (*RowCursor, error) {
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("stream query failed: %w", err)
+	}
+
 	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	req := &pb.StreamQueryDataSourceRequest{
+		Name:      name,
+		Query:     query,
+		Args:      positional,
+		NamedArgs: named,
+		PageSize:  pageSize,
+	}
+	stream, err := c.service.StreamQueryDataSource(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("stream query failed: %w", err)
+	}
+
+	return newRowCursor(ctx, cancel, func() (*rowPage, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &rowPage{
+			Success: resp.Success,
+			Items:   rowsFromProto(resp.Rows),
+			Done:    resp.Done,
+			Error:   resp.Error,
+		}, nil
+	}), nil
+}
+
+// ExecuteDataSource executes a write operation on a DataSource
+func (c *GRPCClient) ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceExecuteResponse, error) {
+	return c.execute(ctx, name, "", query, args)
+}
+
+// execute runs a write statement, inside transaction txID when it is set
+func (c *GRPCClient) execute(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceExecuteResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	positional, named, err := bindArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("execute datasource failed: %w", err)
 	}
 
 	req := &pb.ExecuteDataSourceRequest{
-		Name:  name,
-		Query: query,
+		Name:          name,
+		Query:         query,
+		Args:          positional,
+		NamedArgs:     named,
+		TransactionId: txID,
 	}
 	resp, err := c.service.ExecuteDataSource(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("execute datasource failed: %w", err)
 	}
 
-	return &DataSourceResponse{
-		Success: resp.Success,
-		Message: resp.Error,
+	return &DataSourceExecuteResponse{
+		Success:      resp.Success,
+		AffectedRows: resp.AffectedRows,
+		LastInsertID: resp.LastInsertId,
+		Message:      resp.Error,
+		Rows:         rowsFromProto(resp.Rows),
 	}, nil
 }
 
 // InsertDataSource inserts data into a DataSource
 func (c *GRPCClient) InsertDataSource(ctx context.Context, name string, data map[string]interface{}) (*DataSourceResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
-	// Convert map to proto Row
-	columns := make(map[string]*pb.Value)
-	for key, val := range data {
-		// Simplified: convert to string value
-		columns[key] = &pb.Value{
-			Value: &pb.Value_StringValue{StringValue: fmt.Sprintf("%v", val)},
-		}
+	row, err := MapToRow(data)
+	if err != nil {
+		return nil, fmt.Errorf("insert datasource failed: %w", err)
 	}
 
 	req := &pb.InsertDataSourceRequest{
 		Name:  name,
 		Table: "", // Table name could be extracted from data or passed separately
-		Data: &pb.Row{
-			Columns: columns,
-		},
+		Data:  row,
 	}
 	resp, err := c.service.InsertDataSource(ctx, req)
 	if err != nil {
@@ -359,13 +420,86 @@ func (c *GRPCClient) InsertDataSource(ctx context.Context, name string, data map
 	}, nil
 }
 
+// BulkInsertDataSource inserts rows into a table or collection in batches
+func (c *GRPCClient) BulkInsertDataSource(ctx context.Context, name string, table string, rows []map[string]interface{}, opts ...InsertOption) (*DataSourceInsertResponse, error) {
+	return bulkInsert(ctx, table, rows, opts, func(ctx context.Context, table string, batch []map[string]interface{}) (*DataSourceInsertResponse, error) {
+		return c.insertRows(ctx, name, "", table, batch)
+	})
+}
+
+// insertRows inserts one batch of rows, inside transaction txID when it is set
+func (c *GRPCClient) insertRows(ctx context.Context, name, txID, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := insertRequest(name, txID, table, rows)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.service.InsertDataSource(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("insert datasource failed: %w", err)
+	}
+
+	return &DataSourceInsertResponse{
+		Success:      resp.Success,
+		InsertedRows: resp.InsertedRows,
+		Message:      resp.Error,
+	}, nil
+}
+
+// BeginTx starts a transaction on a DataSource
+func (c *GRPCClient) BeginTx(ctx context.Context, name string, opts *sql.TxOptions) (*Tx, error) {
+	isolation, readOnly, err := isolationLevel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	callCtx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.BeginTransaction(callCtx, &pb.BeginTransactionRequest{
+		Name:      name,
+		Isolation: isolation,
+		ReadOnly:  readOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("begin transaction failed: %s", resp.Error)
+	}
+
+	return newTx(ctx, c, c.opts.logger, name, resp.TransactionId), nil
+}
+
+// endTx commits or rolls back transaction txID
+func (c *GRPCClient) endTx(ctx context.Context, name, txID string, commit bool) error {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var success bool
+	var message string
+	if commit {
+		resp, err := c.service.CommitTransaction(ctx, &pb.CommitTransactionRequest{Name: name, TransactionId: txID})
+		if err != nil {
+			return fmt.Errorf("commit transaction failed: %w", err)
+		}
+		success, message = resp.Success, resp.Error
+	} else {
+		resp, err := c.service.RollbackTransaction(ctx, &pb.RollbackTransactionRequest{Name: name, TransactionId: txID})
+		if err != nil {
+			return fmt.Errorf("rollback transaction failed: %w", err)
+		}
+		success, message = resp.Success, resp.Error
+	}
+	return txEndError(commit, success, message)
+}
+
 // PingDataSource checks if a DataSource is alive
 func (c *GRPCClient) PingDataSource(ctx context.Context, name string) (*DataSourceResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.PingDataSourceRequest{
 		Name: name,
@@ -383,11 +517,8 @@ func (c *GRPCClient) PingDataSource(ctx context.Context, name string) (*DataSour
 
 // CloseDataSource closes a DataSource connection
 func (c *GRPCClient) CloseDataSource(ctx context.Context, name string) (*DataSourceResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.CloseDataSourceRequest{
 		Name: name,
@@ -403,35 +534,177 @@ func (c *GRPCClient) CloseDataSource(ctx context.Context, name string) (*DataSou
 	}, nil
 }
 
-// LLM operations
+// ListTables lists the tables, views or collections of a DataSource
+func (c *GRPCClient) ListTables(ctx context.Context, name string, schema string) (*ListTablesResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &pb.ListTablesRequest{
+		Name:   name,
+		Schema: schema,
+	}
+	resp, err := c.service.ListTables(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("list tables failed: %w", err)
+	}
+	return listTablesResponse(resp), nil
+}
+
+// DescribeTable returns the columns of a table or collection
+func (c *GRPCClient) DescribeTable(ctx context.Context, name string, schema string, table string) (*DescribeTableResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &pb.DescribeTableRequest{
+		Name:   name,
+		Schema: schema,
+		Table:  table,
+	}
+	resp, err := c.service.DescribeTable(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("describe table failed: %w", err)
+	}
+	return describeTableResponse(resp), nil
+}
+
+// Kafka operations
+
+// PublishKafka publishes records to a Kafka DataSource in batches
+func (c *GRPCClient) PublishKafka(ctx context.Context, name string, records []KafkaRecord, opts ...PublishOption) (*KafkaPublishResponse, error) {
+	return publishKafka(ctx, name, records, opts, func(ctx context.Context, req *pb.KafkaPublishRequest) (*pb.KafkaPublishResponse, error) {
+		ctx, cancel := withTimeout(ctx, c.timeout)
+		defer cancel()
+		return c.service.KafkaPublish(ctx, req)
+	})
+}
+
+// SubscribeKafka consumes topics of a Kafka DataSource over a server stream
+func (c *GRPCClient) SubscribeKafka(ctx context.Context, name string, opts KafkaSubscribeOptions) (*KafkaSubscription, error) {
+	req, err := opts.toProto(name)
+	if err != nil {
+		return nil, err
+	}
 
-// CreateLLM creates a new LLM client
-func (c *GRPCClient) CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error) {
 	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.service.KafkaSubscribe(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("subscribe kafka failed: %w", err)
+	}
+
+	recv := func() (*page[*KafkaMessage], error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &page[*KafkaMessage]{
+			Success: resp.Success,
+			Items:   kafkaMessagesFromProto(resp.Messages),
+			Error:   resp.Error,
+		}, nil
+	}
+	commit := func(ctx context.Context, req *pb.KafkaCommitRequest) (*pb.KafkaCommitResponse, error) {
+		ctx, cancel := withTimeout(ctx, c.timeout)
 		defer cancel()
+		return c.service.KafkaCommit(ctx, req)
 	}
 
-	// Convert config map to proto format
-	provider := ""
-	if p, ok := config["provider"].(string); ok {
-		provider = p
+	return newKafkaSubscription(ctx, cancel, name, opts.GroupID, recv, commit), nil
+}
+
+// MongoDB operations
+
+// FindMongo returns the documents of a MongoDB collection matching filter
+func (c *GRPCClient) FindMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, opts *MongoFindOptions) (*MongoFindResponse, error) {
+	req, err := mongoFindRequest(name, collection, filter, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	configProto := &pb.LLMConfig{
-		Provider: mapLLMProvider(provider),
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.MongoFind(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("find mongo failed: %w", err)
 	}
-	if model, ok := config["model"].(string); ok {
-		configProto.Model = model
+	return mongoFindResponse(resp), nil
+}
+
+// InsertMongo inserts documents into a MongoDB collection
+func (c *GRPCClient) InsertMongo(ctx context.Context, name string, collection string, documents []map[string]interface{}) (*MongoInsertResponse, error) {
+	req, err := mongoInsertRequest(name, collection, documents)
+	if err != nil {
+		return nil, err
 	}
-	if apiKey, ok := config["api_key"].(string); ok {
-		configProto.ApiKey = &apiKey
+
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.MongoInsert(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("insert mongo failed: %w", err)
+	}
+	return mongoInsertResponse(resp), nil
+}
+
+// UpdateMongo updates the documents of a MongoDB collection matching filter
+func (c *GRPCClient) UpdateMongo(ctx context.Context, name string, collection string, filter, update map[string]interface{}, opts *MongoUpdateOptions) (*MongoUpdateResponse, error) {
+	req, err := mongoUpdateRequest(name, collection, filter, update, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.MongoUpdate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("update mongo failed: %w", err)
+	}
+	return mongoUpdateResponse(resp), nil
+}
+
+// DeleteMongo deletes the documents of a MongoDB collection matching filter
+func (c *GRPCClient) DeleteMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, many bool) (*MongoDeleteResponse, error) {
+	req, err := mongoDeleteRequest(name, collection, filter, many)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.service.MongoDelete(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("delete mongo failed: %w", err)
+	}
+	return &MongoDeleteResponse{
+		Success:      resp.Success,
+		DeletedCount: resp.DeletedCount,
+		Message:      resp.Error,
+	}, nil
+}
+
+// LLM operations
+
+// CreateLLM creates a new LLM client
+func (c *GRPCClient) CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
+	llmConfig, err := ParseLLMConfig(config)
+	if err != nil {
+		return nil, err
 	}
 
 	req := &pb.CreateLLMRequest{
 		Name:   name,
-		Config: configProto,
+		Config: llmConfig.toProto(),
 	}
 	resp, err := c.service.CreateLLM(ctx, req)
 	if err != nil {
@@ -445,77 +718,60 @@ func (c *GRPCClient) CreateLLM(ctx context.Context, name string, config map[stri
 }
 
 // GenerateLLM generates text from a prompt
-func (c *GRPCClient) GenerateLLM(ctx context.Context, name string, prompt string) (*LLMGenerateResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
+func (c *GRPCClient) GenerateLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	req, err := generateRequest(name, prompt, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	req := &pb.GenerateLLMRequest{
-		Name:   name,
-		Prompt: prompt,
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
 	resp, err := c.service.GenerateLLM(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("generate llm failed: %w", err)
 	}
 
 	return &LLMGenerateResponse{
-		Success: resp.Success,
-		Text:    resp.Text,
-		Message: resp.Error,
+		Success:      resp.Success,
+		Text:         resp.Text,
+		Message:      resp.Error,
+		TokensUsed:   resp.GetTokensUsed(),
+		FinishReason: resp.GetFinishReason(),
+		Model:        resp.Model,
 	}, nil
 }
 
 // ChatLLM performs a chat conversation with message history
-func (c *GRPCClient) ChatLLM(ctx context.Context, name string, messages []map[string]interface{}) (*LLMGenerateResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
+func (c *GRPCClient) ChatLLM(ctx context.Context, name string, messages []ChatMessage, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	req, err := chatRequest(name, messages, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert messages to proto format
-	protoMessages := make([]*pb.LLMMessage, len(messages))
-	for i, msg := range messages {
-		role := ""
-		if r, ok := msg["role"].(string); ok {
-			role = r
-		}
-		content := ""
-		if c, ok := msg["content"].(string); ok {
-			content = c
-		}
-		protoMessages[i] = &pb.LLMMessage{
-			Role:    mapMessageRole(role),
-			Content: content,
-		}
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
-	req := &pb.ChatLLMRequest{
-		Name:     name,
-		Messages: protoMessages,
-	}
 	resp, err := c.service.ChatLLM(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("chat llm failed: %w", err)
 	}
 
 	return &LLMGenerateResponse{
-		Success: resp.Success,
-		Text:    resp.Text,
-		Message: resp.Error,
+		Success:      resp.Success,
+		Text:         resp.Text,
+		Message:      resp.Error,
+		TokensUsed:   resp.GetTokensUsed(),
+		FinishReason: resp.GetFinishReason(),
+		Model:        resp.Model,
+		ToolCalls:    toolCallsFromProto(resp.ToolCalls),
 	}, nil
 }
 
 // EmbeddingLLM generates embeddings for text
 func (c *GRPCClient) EmbeddingLLM(ctx context.Context, name string, text string) (*LLMEmbeddingResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.EmbeddingLLMRequest{
 		Name: name,
@@ -533,20 +789,58 @@ func (c *GRPCClient) EmbeddingLLM(ctx context.Context, name string, text string)
 	}
 
 	return &LLMEmbeddingResponse{
-		Success:   resp.Success,
-		Embedding: embedding,
-		Message:   resp.Error,
+		Success:    resp.Success,
+		Embedding:  embedding,
+		Message:    resp.Error,
+		Model:      resp.Model,
+		TokensUsed: resp.GetTokensUsed(),
 	}, nil
 }
 
-// PingLLM checks if an LLM client is alive
-func (c *GRPCClient) PingLLM(ctx context.Context, name string) (*LLMResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
+// BatchEmbeddingLLM generates embeddings for many texts, in sub-batches
+func (c *GRPCClient) BatchEmbeddingLLM(ctx context.Context, name string, texts []string, opts ...EmbeddingOption) (*LLMBatchEmbeddingResponse, error) {
+	return batchEmbed(ctx, name, texts, opts, func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+		ctx, cancel := withTimeout(ctx, c.timeout)
 		defer cancel()
+
+		req := &pb.BatchEmbeddingLLMRequest{
+			Name:  name,
+			Texts: texts,
+		}
+		resp, err := c.service.BatchEmbeddingLLM(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("batch embedding llm failed: %w", err)
+		}
+		return batchEmbeddingResponse(resp), nil
+	})
+}
+
+// StreamLLM generates text from a prompt, delivering it incrementally
+func (c *GRPCClient) StreamLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMStream, error) {
+	req, err := streamRequest(name, prompt, opts)
+	if err != nil {
+		return nil, err
 	}
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.service.StreamLLM(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("stream llm failed: %w", err)
+	}
+
+	return newLLMStream(ctx, cancel, stream.Recv), nil
+}
+
+// PingLLM checks if an LLM client is alive
+func (c *GRPCClient) PingLLM(ctx context.Context, name string) (*LLMResponse, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
 	req := &pb.PingLLMRequest{
 		Name: name,
 	}
@@ -563,11 +857,8 @@ func (c *GRPCClient) PingLLM(ctx context.Context, name string) (*LLMResponse, er
 
 // CloseLLM closes an LLM client
 func (c *GRPCClient) CloseLLM(ctx context.Context, name string) (*LLMResponse, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
 
 	req := &pb.CloseLLMRequest{
 		Name: name,
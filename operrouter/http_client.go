@@ -3,10 +3,17 @@ package operrouter
 import (
 	bytes "bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
 )
 
 // HTTPClient implements the Client interface using HTTP JSON-RPC
@@ -14,8 +21,18 @@ type HTTPClient struct {
 	BaseURL string
 	HTTP    *http.Client
 	timeout time.Duration
+	opts    *clientOptions
+}
+
+// httpSupportedOptions lists the ClientOptions honored by NewHTTPWithOptions
+var httpSupportedOptions = []string{
+	"WithTimeout", "WithEndpointPath", "WithHeader", "WithHeaders",
+	"WithUserAgent", "WithLogger", "WithRetryPolicy", "WithTLSConfig",
 }
 
+// defaultEndpointPath is the JSON-RPC path used unless WithEndpointPath is given
+const defaultEndpointPath = "/jsonrpc"
+
 type jsonrpcRequest struct {
 	JSONRPC string      `json:"jsonrpc"`
 	Method  string      `json:"method"`
@@ -39,40 +56,78 @@ func New(baseURL string) *HTTPClient {
 	return NewHTTP(baseURL)
 }
 
-// NewHTTP creates a new HTTP JSON-RPC client
+// NewHTTP creates a new HTTP JSON-RPC client with default settings
 // Example: client := operrouter.NewHTTP("http://localhost:8080")
-func NewHTTP(baseURL string, opts ...ClientOption) *HTTPClient {
-	client := &HTTPClient{
-		BaseURL: baseURL,
-		HTTP:    &http.Client{Timeout: 10 * time.Second},
-		timeout: 5 * time.Second,
+func NewHTTP(baseURL string) *HTTPClient {
+	// Default options are always valid
+	client, _ := NewHTTPWithOptions(baseURL)
+	return client
+}
+
+// NewHTTPWithOptions creates a new HTTP JSON-RPC client configured by opts
+// Example: client, err := operrouter.NewHTTPWithOptions(url, operrouter.WithTimeout(5*time.Second))
+func NewHTTPWithOptions(baseURL string, opts ...ClientOption) (*HTTPClient, error) {
+	o, err := newClientOptions("HTTP", httpSupportedOptions, opts)
+	if err != nil {
+		return nil, err
+	}
+	if o.endpointPath == "" {
+		o.endpointPath = defaultEndpointPath
 	}
 
-	// Apply options
-	for _, opt := range opts {
-		opt(client)
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	if o.timeout > 0 {
+		// The per-call context deadline takes over from the fixed client timeout
+		httpClient.Timeout = 0
+	}
+	if o.tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = o.tlsConfig
+		httpClient.Transport = transport
 	}
 
-	return client
+	return &HTTPClient{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		HTTP:    httpClient,
+		timeout: o.timeout,
+		opts:    o,
+	}, nil
 }
 
-// callJSONRPC makes a JSON-RPC call
-func (c *HTTPClient) callJSONRPC(method string, params interface{}, result interface{}) error {
+// callJSONRPC makes a JSON-RPC call bound to ctx
+func (c *HTTPClient) callJSONRPC(ctx context.Context, method string, params interface{}, result interface{}) error {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+
 	req := jsonrpcRequest{JSONRPC: "2.0", Method: method, Params: params, ID: 1}
 	reqBytes, err := json.Marshal(req)
 	if err != nil {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	resp, err := c.HTTP.Post(c.BaseURL+"/jsonrpc", "application/json", bytes.NewReader(reqBytes))
-	if err != nil {
-		return fmt.Errorf("http request failed: %w", err)
+	attempts := 1
+	if c.opts.retry != nil {
+		attempts = c.opts.retry.MaxAttempts
 	}
-	defer resp.Body.Close()
 
 	var rpcResp jsonrpcResponse
-	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	for attempt := 1; ; attempt++ {
+		failure, err := c.doJSONRPC(ctx, reqBytes, &rpcResp)
+		if err == nil {
+			break
+		}
+		retryable := failure == failureNotSent ||
+			(failure == failureTransient && idempotentHTTPMethods[method])
+		if !retryable || attempt >= attempts {
+			c.opts.logger.DebugContext(ctx, "operrouter call failed",
+				"backend", "http", "method", method, "attempt", attempt, "error", err)
+			return err
+		}
+		c.opts.logger.WarnContext(ctx, "operrouter call failed, retrying",
+			"backend", "http", "method", method, "attempt", attempt, "error", err)
+		if sleepErr := c.opts.retry.sleep(ctx, attempt); sleepErr != nil {
+			return contextError(ctx, err)
+		}
 	}
 
 	if rpcResp.Error != nil {
@@ -80,7 +135,7 @@ func (c *HTTPClient) callJSONRPC(method string, params interface{}, result inter
 	}
 
 	if rpcResp.Result != nil && result != nil {
-		if err := json.Unmarshal(*rpcResp.Result, result); err != nil {
+		if err := unmarshalResult(*rpcResp.Result, result); err != nil {
 			return fmt.Errorf("failed to unmarshal result: %w", err)
 		}
 	}
@@ -88,10 +143,128 @@ func (c *HTTPClient) callJSONRPC(method string, params interface{}, result inter
 	return nil
 }
 
+// unmarshalResult decodes a JSON-RPC result, keeping untyped numbers as
+// json.Number so integers survive without float rounding
+func unmarshalResult(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// callFailure classifies a failed attempt for the retry decision
+type callFailure int
+
+const (
+	// failurePermanent must not be retried
+	failurePermanent callFailure = iota
+	// failureTransient may be retried if the method is idempotent, since the
+	// server could have received and applied the request
+	failureTransient
+	// failureNotSent may always be retried: the request never left the client
+	failureNotSent
+)
+
+// doJSONRPC sends one attempt of a JSON-RPC request and classifies a failure
+// for the retry decision
+func (c *HTTPClient) doJSONRPC(ctx context.Context, body []byte, rpcResp *jsonrpcResponse) (callFailure, error) {
+	httpReq, err := c.newRequest(ctx, body)
+	if err != nil {
+		return failurePermanent, err
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTP.Do(httpReq)
+	if err != nil {
+		failure := failurePermanent
+		if ctx.Err() == nil {
+			failure = failureTransient
+			if isDialError(err) {
+				failure = failureNotSent
+			}
+		}
+		return failure, contextError(ctx, fmt.Errorf("http request failed: %w", err))
+	}
+	defer resp.Body.Close()
+
+	if isRetryableStatus(resp.StatusCode) {
+		return failureTransient, fmt.Errorf("http request failed: %s", resp.Status)
+	}
+
+	*rpcResp = jsonrpcResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(rpcResp); err != nil {
+		if resp.StatusCode >= 300 {
+			return failurePermanent, fmt.Errorf("http request failed: %s", resp.Status)
+		}
+		return failurePermanent, contextError(ctx, fmt.Errorf("failed to decode response: %w", err))
+	}
+
+	return failurePermanent, nil
+}
+
+// isDialError reports whether err happened while connecting, before any part
+// of the request was written
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// newRequest builds a POST to the JSON-RPC endpoint carrying the configured
+// headers and the caller's deadline
+func (c *HTTPClient) newRequest(ctx context.Context, body []byte) (*http.Request, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+c.opts.endpointPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	for k, v := range c.opts.headers {
+		httpReq.Header.Set(k, v)
+	}
+	if c.opts.userAgent != "" {
+		httpReq.Header.Set("User-Agent", c.opts.userAgent)
+	}
+	setTimeoutHeader(ctx, httpReq)
+	return httpReq, nil
+}
+
+// isRetryableStatus reports whether an HTTP status signals a transient failure
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// timeoutHeader carries the caller's remaining deadline in milliseconds so the
+// server can abandon work the client is no longer waiting for
+const timeoutHeader = "X-OperRouter-Timeout-Ms"
+
+// setTimeoutHeader propagates the deadline of ctx, if any, to the server
+func setTimeoutHeader(ctx context.Context, req *http.Request) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	remaining := time.Until(deadline).Milliseconds()
+	if remaining < 1 {
+		remaining = 1
+	}
+	req.Header.Set(timeoutHeader, strconv.FormatInt(remaining, 10))
+}
+
+// contextError prefers the context's error over transport errors so callers can
+// match context.Canceled and context.DeadlineExceeded with errors.Is
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+		return fmt.Errorf("%w: %v", ctxErr, err)
+	}
+	return err
+}
+
 // Ping checks the service health
 func (c *HTTPClient) Ping(ctx context.Context) (*PingResponse, error) {
 	var result map[string]string
-	if err := c.callJSONRPC("ping", nil, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "ping", nil, &result); err != nil {
 		return nil, err
 	}
 
@@ -112,7 +285,7 @@ func (c *HTTPClient) ValidateConfig(ctx context.Context, tomlContent string) (*V
 		Errors []string `json:"errors"`
 	}
 
-	if err := c.callJSONRPC("validate_config", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "validate_config", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -134,7 +307,7 @@ func (c *HTTPClient) LoadConfig(ctx context.Context, configPath string) (*LoadCo
 		OperatorName string `json:"operator_name"`
 	}
 
-	if err := c.callJSONRPC("load_config", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "load_config", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -153,7 +326,7 @@ func (c *HTTPClient) GetMetadata(ctx context.Context) (*MetadataResponse, error)
 		Description string `json:"description"`
 	}
 
-	if err := c.callJSONRPC("get_metadata", nil, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "get_metadata", nil, &result); err != nil {
 		return nil, err
 	}
 
@@ -173,9 +346,14 @@ func (c *HTTPClient) Close() error {
 
 // CreateDataSource creates a new DataSource connection
 func (c *HTTPClient) CreateDataSource(ctx context.Context, name string, config map[string]interface{}) (*DataSourceResponse, error) {
+	dsConfig, err := ParseDataSourceConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	params := map[string]interface{}{
 		"name":   name,
-		"config": config,
+		"config": dsConfig.Map(),
 	}
 
 	var result struct {
@@ -183,7 +361,7 @@ func (c *HTTPClient) CreateDataSource(ctx context.Context, name string, config m
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("datasource.create", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.create", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -194,56 +372,143 @@ func (c *HTTPClient) CreateDataSource(ctx context.Context, name string, config m
 }
 
 // QueryDataSource executes a read query on a DataSource
-func (c *HTTPClient) QueryDataSource(ctx context.Context, name string, query string) (*DataSourceQueryResponse, error) {
+func (c *HTTPClient) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceQueryResponse, error) {
+	return c.query(ctx, name, "", query, args)
+}
+
+// query runs a read query, inside transaction txID when it is set
+func (c *HTTPClient) query(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceQueryResponse, error) {
 	params := map[string]interface{}{
 		"name":  name,
 		"query": query,
 	}
+	if txID != "" {
+		params["transaction_id"] = txID
+	}
+	if err := addJSONArgs(params, args); err != nil {
+		return nil, fmt.Errorf("query datasource failed: %w", err)
+	}
 
 	var result struct {
 		Success bool                     `json:"success"`
 		Rows    []map[string]interface{} `json:"rows"`
 		Message string                   `json:"message"`
+		Columns []string                 `json:"columns"`
 	}
 
-	if err := c.callJSONRPC("datasource.query", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.query", params, &result); err != nil {
 		return nil, err
 	}
 
 	return &DataSourceQueryResponse{
 		Success: result.Success,
-		Rows:    result.Rows,
+		Rows:    rowsFromJSON(result.Rows),
 		Message: result.Message,
+		Columns: result.Columns,
 	}, nil
 }
 
+// StreamQueryDataSource executes a read query and streams its rows in pages
+func (c *HTTPClient) StreamQueryDataSource(ctx context.Context, name string, pageSize uint32, query string, args ...interface{}) (*RowCursor, error) {
+	params := map[string]interface{}{
+		"name":  name,
+		"query": query,
+	}
+	if pageSize > 0 {
+		params["page_size"] = pageSize
+	}
+	if err := addJSONArgs(params, args); err != nil {
+		return nil, fmt.Errorf("stream query failed: %w", err)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.openStream(ctx, "datasource.query_stream", params)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	recv := func() (*rowPage, error) {
+		var result struct {
+			Success bool                     `json:"success"`
+			Rows    []map[string]interface{} `json:"rows"`
+			Done    bool                     `json:"done"`
+			Error   string                   `json:"error"`
+			Message string                   `json:"message"`
+		}
+		if err := stream.next(&result); err != nil {
+			return nil, err
+		}
+		if result.Error == "" {
+			result.Error = result.Message
+		}
+		return &rowPage{
+			Success: result.Success,
+			Items:   rowsFromJSON(result.Rows),
+			Done:    result.Done,
+			Error:   result.Error,
+		}, nil
+	}
+
+	return newRowCursor(ctx, func() {
+		cancel()
+		stream.close()
+	}, recv), nil
+}
+
 // ExecuteDataSource executes a write operation on a DataSource
-func (c *HTTPClient) ExecuteDataSource(ctx context.Context, name string, query string) (*DataSourceResponse, error) {
+func (c *HTTPClient) ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceExecuteResponse, error) {
+	return c.execute(ctx, name, "", query, args)
+}
+
+// execute runs a write statement, inside transaction txID when it is set
+func (c *HTTPClient) execute(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceExecuteResponse, error) {
 	params := map[string]interface{}{
 		"name":  name,
 		"query": query,
 	}
+	if txID != "" {
+		params["transaction_id"] = txID
+	}
+	if err := addJSONArgs(params, args); err != nil {
+		return nil, fmt.Errorf("execute datasource failed: %w", err)
+	}
 
 	var result struct {
-		Success bool   `json:"success"`
-		Message string `json:"message"`
+		Success      bool                     `json:"success"`
+		AffectedRows uint64                   `json:"affected_rows"`
+		LastInsertID *int64                   `json:"last_insert_id"`
+		Message      string                   `json:"message"`
+		Rows         []map[string]interface{} `json:"rows"`
 	}
 
-	if err := c.callJSONRPC("datasource.execute", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.execute", params, &result); err != nil {
 		return nil, err
 	}
 
-	return &DataSourceResponse{
-		Success: result.Success,
-		Message: result.Message,
+	return &DataSourceExecuteResponse{
+		Success:      result.Success,
+		AffectedRows: result.AffectedRows,
+		LastInsertID: result.LastInsertID,
+		Message:      result.Message,
+		Rows:         rowsFromJSON(result.Rows),
 	}, nil
 }
 
 // InsertDataSource inserts data into a DataSource
 func (c *HTTPClient) InsertDataSource(ctx context.Context, name string, data map[string]interface{}) (*DataSourceResponse, error) {
+	row, err := normalizeRow(data)
+	if err != nil {
+		return nil, fmt.Errorf("insert datasource failed: %w", err)
+	}
+
 	params := map[string]interface{}{
 		"name": name,
-		"data": data,
+		"data": row,
 	}
 
 	var result struct {
@@ -251,7 +516,7 @@ func (c *HTTPClient) InsertDataSource(ctx context.Context, name string, data map
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("datasource.insert", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.insert", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -261,6 +526,108 @@ func (c *HTTPClient) InsertDataSource(ctx context.Context, name string, data map
 	}, nil
 }
 
+// BulkInsertDataSource inserts rows into a table or collection in batches
+func (c *HTTPClient) BulkInsertDataSource(ctx context.Context, name string, table string, rows []map[string]interface{}, opts ...InsertOption) (*DataSourceInsertResponse, error) {
+	return bulkInsert(ctx, table, rows, opts, func(ctx context.Context, table string, batch []map[string]interface{}) (*DataSourceInsertResponse, error) {
+		return c.insertRows(ctx, name, "", table, batch)
+	})
+}
+
+// insertRows inserts one batch of rows, inside transaction txID when it is set
+func (c *HTTPClient) insertRows(ctx context.Context, name, txID, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error) {
+	normalized := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		var err error
+		if normalized[i], err = normalizeRow(row); err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+
+	params := map[string]interface{}{
+		"name":  name,
+		"table": table,
+		"rows":  normalized,
+	}
+	if txID != "" {
+		params["transaction_id"] = txID
+	}
+
+	var result struct {
+		Success      bool   `json:"success"`
+		InsertedRows uint64 `json:"inserted_rows"`
+		Message      string `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, "datasource.insert", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &DataSourceInsertResponse{
+		Success:      result.Success,
+		InsertedRows: result.InsertedRows,
+		Message:      result.Message,
+	}, nil
+}
+
+// BeginTx starts a transaction on a DataSource
+func (c *HTTPClient) BeginTx(ctx context.Context, name string, opts *sql.TxOptions) (*Tx, error) {
+	isolation, readOnly, err := isolationLevel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	if isolation != pb.IsolationLevel_ISOLATION_LEVEL_UNSPECIFIED {
+		// "read_committed", "serializable", ...
+		params["isolation"] = strings.ToLower(strings.TrimPrefix(isolation.String(), "ISOLATION_LEVEL_"))
+	}
+	if readOnly {
+		params["read_only"] = true
+	}
+
+	var result struct {
+		Success       bool   `json:"success"`
+		TransactionID string `json:"transaction_id"`
+		Message       string `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, "datasource.begin", params, &result); err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	if !result.Success {
+		return nil, fmt.Errorf("begin transaction failed: %s", result.Message)
+	}
+
+	return newTx(ctx, c, c.opts.logger, name, result.TransactionID), nil
+}
+
+// endTx commits or rolls back transaction txID
+func (c *HTTPClient) endTx(ctx context.Context, name, txID string, commit bool) error {
+	method := "datasource.rollback"
+	if commit {
+		method = "datasource.commit"
+	}
+	params := map[string]interface{}{
+		"name":           name,
+		"transaction_id": txID,
+	}
+
+	var result struct {
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, method, params, &result); err != nil {
+		if commit {
+			return fmt.Errorf("commit transaction failed: %w", err)
+		}
+		return fmt.Errorf("rollback transaction failed: %w", err)
+	}
+	return txEndError(commit, result.Success, result.Message)
+}
+
 // PingDataSource checks if a DataSource is alive
 func (c *HTTPClient) PingDataSource(ctx context.Context, name string) (*DataSourceResponse, error) {
 	params := map[string]interface{}{
@@ -272,7 +639,7 @@ func (c *HTTPClient) PingDataSource(ctx context.Context, name string) (*DataSour
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("datasource.ping", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.ping", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -293,7 +660,7 @@ func (c *HTTPClient) CloseDataSource(ctx context.Context, name string) (*DataSou
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("datasource.close", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.close", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -303,78 +670,514 @@ func (c *HTTPClient) CloseDataSource(ctx context.Context, name string) (*DataSou
 	}, nil
 }
 
-// LLM operations
+// tableInfoJSON is a table as JSON-RPC reports it
+type tableInfoJSON struct {
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+	Kind   string `json:"kind"`
+}
 
-// CreateLLM creates a new LLM client
-func (c *HTTPClient) CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error) {
+// toTableInfo converts the table
+func (t tableInfoJSON) toTableInfo() TableInfo {
+	return TableInfo{Name: t.Name, Schema: t.Schema, Kind: TableKind(strings.ToLower(t.Kind))}
+}
+
+// ListTables lists the tables, views or collections of a DataSource
+func (c *HTTPClient) ListTables(ctx context.Context, name string, schema string) (*ListTablesResponse, error) {
 	params := map[string]interface{}{
-		"name":   name,
-		"config": config,
+		"name": name,
+	}
+	if schema != "" {
+		params["schema"] = schema
 	}
 
 	var result struct {
-		Success bool   `json:"success"`
-		Message string `json:"message"`
+		Success bool            `json:"success"`
+		Tables  []tableInfoJSON `json:"tables"`
+		Message string          `json:"message"`
 	}
 
-	if err := c.callJSONRPC("llm.create", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.list_tables", params, &result); err != nil {
 		return nil, err
 	}
 
-	return &LLMResponse{
+	tables := make([]TableInfo, len(result.Tables))
+	for i, t := range result.Tables {
+		tables[i] = t.toTableInfo()
+	}
+	return &ListTablesResponse{
 		Success: result.Success,
+		Tables:  tables,
 		Message: result.Message,
 	}, nil
 }
 
-// GenerateLLM generates text from a prompt
-func (c *HTTPClient) GenerateLLM(ctx context.Context, name string, prompt string) (*LLMGenerateResponse, error) {
+// DescribeTable returns the columns of a table or collection
+func (c *HTTPClient) DescribeTable(ctx context.Context, name string, schema string, table string) (*DescribeTableResponse, error) {
 	params := map[string]interface{}{
-		"name":   name,
-		"prompt": prompt,
+		"name":  name,
+		"table": table,
+	}
+	if schema != "" {
+		params["schema"] = schema
 	}
 
 	var result struct {
-		Success bool   `json:"success"`
-		Text    string `json:"text"`
+		Success bool          `json:"success"`
+		Table   tableInfoJSON `json:"table"`
+		Columns []struct {
+			Name         string  `json:"name"`
+			Type         string  `json:"type"`
+			Nullable     bool    `json:"nullable"`
+			PrimaryKey   bool    `json:"primary_key"`
+			DefaultValue *string `json:"default_value"`
+		} `json:"columns"`
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("llm.generate", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "datasource.describe_table", params, &result); err != nil {
 		return nil, err
 	}
 
-	return &LLMGenerateResponse{
+	resp := &DescribeTableResponse{
 		Success: result.Success,
-		Text:    result.Text,
 		Message: result.Message,
+	}
+	if !result.Success {
+		return resp, nil
+	}
+	resp.Table = &TableSchema{
+		TableInfo: result.Table.toTableInfo(),
+		Columns:   make([]ColumnInfo, len(result.Columns)),
+	}
+	for i, c := range result.Columns {
+		resp.Table.Columns[i] = ColumnInfo{
+			Name:       c.Name,
+			Type:       c.Type,
+			Nullable:   c.Nullable,
+			PrimaryKey: c.PrimaryKey,
+			Default:    c.DefaultValue,
+		}
+	}
+	return resp, nil
+}
+
+// Kafka operations
+//
+// Keys, values and header values travel as base64 strings, the standard JSON
+// form of binary data. Timestamps are Unix milliseconds.
+
+// kafkaHeaderJSON is a record header as JSON-RPC sends it
+type kafkaHeaderJSON struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// kafkaRecordJSON is a record to publish as JSON-RPC sends it
+type kafkaRecordJSON struct {
+	Topic       string            `json:"topic"`
+	Key         []byte            `json:"key,omitempty"`
+	Value       []byte            `json:"value"`
+	Headers     []kafkaHeaderJSON `json:"headers,omitempty"`
+	Partition   *int32            `json:"partition,omitempty"`
+	TimestampMs *int64            `json:"timestamp_ms,omitempty"`
+}
+
+// kafkaDeliveryJSON is a delivery report as JSON-RPC reports it
+type kafkaDeliveryJSON struct {
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+	Error     string `json:"error"`
+}
+
+// kafkaMessageJSON is a consumed message as JSON-RPC reports it
+type kafkaMessageJSON struct {
+	Topic       string            `json:"topic"`
+	Partition   int32             `json:"partition"`
+	Offset      int64             `json:"offset"`
+	Key         []byte            `json:"key"`
+	Value       []byte            `json:"value"`
+	Headers     []kafkaHeaderJSON `json:"headers"`
+	TimestampMs int64             `json:"timestamp_ms"`
+}
+
+// kafkaOffsetJSON is a committed offset as JSON-RPC sends it
+type kafkaOffsetJSON struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+}
+
+// kafkaHeadersToJSON converts record headers
+func kafkaHeadersToJSON(headers []*pb.KafkaHeader) []kafkaHeaderJSON {
+	if len(headers) == 0 {
+		return nil
+	}
+	out := make([]kafkaHeaderJSON, len(headers))
+	for i, h := range headers {
+		out[i] = kafkaHeaderJSON{Key: h.Key, Value: h.Value}
+	}
+	return out
+}
+
+// toProto converts the consumed message
+func (m kafkaMessageJSON) toProto() *pb.KafkaMessage {
+	msg := &pb.KafkaMessage{
+		Topic:       m.Topic,
+		Partition:   m.Partition,
+		Offset:      m.Offset,
+		Key:         m.Key,
+		Value:       m.Value,
+		TimestampMs: m.TimestampMs,
+	}
+	for _, h := range m.Headers {
+		msg.Headers = append(msg.Headers, &pb.KafkaHeader{Key: h.Key, Value: h.Value})
+	}
+	return msg
+}
+
+// PublishKafka publishes records to a Kafka DataSource in batches
+func (c *HTTPClient) PublishKafka(ctx context.Context, name string, records []KafkaRecord, opts ...PublishOption) (*KafkaPublishResponse, error) {
+	return publishKafka(ctx, name, records, opts, func(ctx context.Context, req *pb.KafkaPublishRequest) (*pb.KafkaPublishResponse, error) {
+		jsonRecords := make([]kafkaRecordJSON, len(req.Records))
+		for i, r := range req.Records {
+			jsonRecords[i] = kafkaRecordJSON{
+				Topic:       r.Topic,
+				Key:         r.Key,
+				Value:       r.Value,
+				Headers:     kafkaHeadersToJSON(r.Headers),
+				Partition:   r.Partition,
+				TimestampMs: r.TimestampMs,
+			}
+		}
+		params := map[string]interface{}{
+			"name":    req.Name,
+			"records": jsonRecords,
+		}
+		if req.Acks != pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED {
+			// "none", "leader" or "all"
+			params["acks"] = strings.ToLower(strings.TrimPrefix(req.Acks.String(), "KAFKA_ACKS_"))
+		}
+
+		var result struct {
+			Success bool                `json:"success"`
+			Reports []kafkaDeliveryJSON `json:"reports"`
+			Message string              `json:"message"`
+		}
+
+		if err := c.callJSONRPC(ctx, "kafka.publish", params, &result); err != nil {
+			return nil, err
+		}
+
+		resp := &pb.KafkaPublishResponse{
+			Success: result.Success,
+			Reports: make([]*pb.KafkaDeliveryReport, len(result.Reports)),
+			Error:   result.Message,
+		}
+		for i, r := range result.Reports {
+			resp.Reports[i] = &pb.KafkaDeliveryReport{Partition: r.Partition, Offset: r.Offset, Error: r.Error}
+		}
+		return resp, nil
+	})
+}
+
+// SubscribeKafka consumes topics of a Kafka DataSource over a streamed reply
+func (c *HTTPClient) SubscribeKafka(ctx context.Context, name string, opts KafkaSubscribeOptions) (*KafkaSubscription, error) {
+	req, err := opts.toProto(name)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{
+		"name":          req.Name,
+		"topics":        req.Topics,
+		"group_id":      req.GroupId,
+		"from_earliest": req.FromEarliest,
+		"auto_commit":   req.AutoCommit,
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.openStream(ctx, "kafka.subscribe", params)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	recv := func() (*page[*KafkaMessage], error) {
+		var result struct {
+			Success  bool               `json:"success"`
+			Messages []kafkaMessageJSON `json:"messages"`
+			Error    string             `json:"error"`
+			Message  string             `json:"message"`
+		}
+		if err := stream.next(&result); err != nil {
+			return nil, err
+		}
+		if result.Error == "" {
+			result.Error = result.Message
+		}
+		messages := make([]*pb.KafkaMessage, len(result.Messages))
+		for i, m := range result.Messages {
+			messages[i] = m.toProto()
+		}
+		return &page[*KafkaMessage]{
+			Success: result.Success,
+			Items:   kafkaMessagesFromProto(messages),
+			Error:   result.Error,
+		}, nil
+	}
+	commit := func(ctx context.Context, req *pb.KafkaCommitRequest) (*pb.KafkaCommitResponse, error) {
+		offsets := make([]kafkaOffsetJSON, len(req.Offsets))
+		for i, o := range req.Offsets {
+			offsets[i] = kafkaOffsetJSON{Topic: o.Topic, Partition: o.Partition, Offset: o.Offset}
+		}
+		params := map[string]interface{}{
+			"name":     req.Name,
+			"group_id": req.GroupId,
+			"offsets":  offsets,
+		}
+
+		var result struct {
+			Success bool   `json:"success"`
+			Message string `json:"message"`
+		}
+
+		if err := c.callJSONRPC(ctx, "kafka.commit", params, &result); err != nil {
+			return nil, err
+		}
+		return &pb.KafkaCommitResponse{Success: result.Success, Error: result.Message}, nil
+	}
+
+	return newKafkaSubscription(ctx, func() {
+		cancel()
+		stream.close()
+	}, name, opts.GroupID, recv, commit), nil
+}
+
+// MongoDB operations
+
+// mongoSortJSON is a sort key as JSON-RPC sends it
+type mongoSortJSON struct {
+	Field      string `json:"field"`
+	Descending bool   `json:"descending"`
+}
+
+// FindMongo returns the documents of a MongoDB collection matching filter
+func (c *HTTPClient) FindMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, opts *MongoFindOptions) (*MongoFindResponse, error) {
+	req, err := mongoFindRequest(name, collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{
+		"name":       req.Name,
+		"collection": req.Collection,
+	}
+	mongoDocumentParam(params, "filter", req.Filter)
+	mongoDocumentParam(params, "projection", req.Projection)
+	if len(req.Sort) > 0 {
+		sort := make([]mongoSortJSON, len(req.Sort))
+		for i, s := range req.Sort {
+			sort[i] = mongoSortJSON{Field: s.Field, Descending: s.Descending}
+		}
+		params["sort"] = sort
+	}
+	if req.Limit != nil {
+		params["limit"] = *req.Limit
+	}
+	if req.Skip != nil {
+		params["skip"] = *req.Skip
+	}
+
+	var result struct {
+		Success   bool                     `json:"success"`
+		Documents []map[string]interface{} `json:"documents"`
+		Message   string                   `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, "mongo.find", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &MongoFindResponse{
+		Success:   result.Success,
+		Documents: rowsFromJSON(result.Documents),
+		Message:   result.Message,
 	}, nil
 }
 
-// ChatLLM performs a chat conversation with message history
-func (c *HTTPClient) ChatLLM(ctx context.Context, name string, messages []map[string]interface{}) (*LLMGenerateResponse, error) {
+// InsertMongo inserts documents into a MongoDB collection
+func (c *HTTPClient) InsertMongo(ctx context.Context, name string, collection string, documents []map[string]interface{}) (*MongoInsertResponse, error) {
+	req, err := mongoInsertRequest(name, collection, documents)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]map[string]interface{}, len(req.Documents))
+	for i, doc := range req.Documents {
+		docs[i] = objectToGo(doc)
+	}
+	params := map[string]interface{}{
+		"name":       req.Name,
+		"collection": req.Collection,
+		"documents":  docs,
+	}
+
+	var result struct {
+		Success     bool          `json:"success"`
+		InsertedIDs []interface{} `json:"inserted_ids"`
+		Message     string        `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, "mongo.insert", params, &result); err != nil {
+		return nil, err
+	}
+
+	ids := make([]interface{}, len(result.InsertedIDs))
+	for i, id := range result.InsertedIDs {
+		ids[i] = jsonToGo(id)
+	}
+	return &MongoInsertResponse{
+		Success:     result.Success,
+		InsertedIDs: ids,
+		Message:     result.Message,
+	}, nil
+}
+
+// UpdateMongo updates the documents of a MongoDB collection matching filter
+func (c *HTTPClient) UpdateMongo(ctx context.Context, name string, collection string, filter, update map[string]interface{}, opts *MongoUpdateOptions) (*MongoUpdateResponse, error) {
+	req, err := mongoUpdateRequest(name, collection, filter, update, opts)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{
+		"name":       req.Name,
+		"collection": req.Collection,
+		"many":       req.Many,
+		"upsert":     req.Upsert,
+	}
+	mongoDocumentParam(params, "filter", req.Filter)
+	mongoDocumentParam(params, "update", req.Update)
+
+	var result struct {
+		Success       bool        `json:"success"`
+		MatchedCount  uint64      `json:"matched_count"`
+		ModifiedCount uint64      `json:"modified_count"`
+		UpsertedID    interface{} `json:"upserted_id"`
+		Message       string      `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, "mongo.update", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &MongoUpdateResponse{
+		Success:       result.Success,
+		MatchedCount:  result.MatchedCount,
+		ModifiedCount: result.ModifiedCount,
+		UpsertedID:    jsonToGo(result.UpsertedID),
+		Message:       result.Message,
+	}, nil
+}
+
+// DeleteMongo deletes the documents of a MongoDB collection matching filter
+func (c *HTTPClient) DeleteMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, many bool) (*MongoDeleteResponse, error) {
+	req, err := mongoDeleteRequest(name, collection, filter, many)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{
+		"name":       req.Name,
+		"collection": req.Collection,
+		"many":       req.Many,
+	}
+	mongoDocumentParam(params, "filter", req.Filter)
+
+	var result struct {
+		Success      bool   `json:"success"`
+		DeletedCount uint64 `json:"deleted_count"`
+		Message      string `json:"message"`
+	}
+
+	if err := c.callJSONRPC(ctx, "mongo.delete", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &MongoDeleteResponse{
+		Success:      result.Success,
+		DeletedCount: result.DeletedCount,
+		Message:      result.Message,
+	}, nil
+}
+
+// LLM operations
+
+// CreateLLM creates a new LLM client
+func (c *HTTPClient) CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error) {
+	llmConfig, err := ParseLLMConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	params := map[string]interface{}{
-		"name":     name,
-		"messages": messages,
+		"name":   name,
+		"config": llmConfig.Map(),
 	}
 
 	var result struct {
 		Success bool   `json:"success"`
-		Text    string `json:"text"`
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("llm.chat", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "llm.create", params, &result); err != nil {
 		return nil, err
 	}
 
-	return &LLMGenerateResponse{
+	return &LLMResponse{
 		Success: result.Success,
-		Text:    result.Text,
 		Message: result.Message,
 	}, nil
 }
 
+// GenerateLLM generates text from a prompt
+func (c *HTTPClient) GenerateLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	req, err := generateRequest(name, prompt, opts)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{
+		"name":   req.Name,
+		"prompt": req.Prompt,
+	}
+	addGenerationParams(params, req.Options)
+
+	var result llmGenerateResult
+
+	if err := c.callJSONRPC(ctx, "llm.generate", params, &result); err != nil {
+		return nil, err
+	}
+
+	return result.toResponse(), nil
+}
+
+// ChatLLM performs a chat conversation with message history
+func (c *HTTPClient) ChatLLM(ctx context.Context, name string, messages []ChatMessage, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	req, err := chatRequest(name, messages, opts)
+	if err != nil {
+		return nil, err
+	}
+	params := chatParams(req)
+
+	var result llmGenerateResult
+
+	if err := c.callJSONRPC(ctx, "llm.chat", params, &result); err != nil {
+		return nil, err
+	}
+
+	return result.toResponse(), nil
+}
+
 // EmbeddingLLM generates embeddings for text
 func (c *HTTPClient) EmbeddingLLM(ctx context.Context, name string, text string) (*LLMEmbeddingResponse, error) {
 	params := map[string]interface{}{
@@ -383,22 +1186,118 @@ func (c *HTTPClient) EmbeddingLLM(ctx context.Context, name string, text string)
 	}
 
 	var result struct {
-		Success   bool      `json:"success"`
-		Embedding []float64 `json:"embedding"`
-		Message   string    `json:"message"`
+		Success    bool      `json:"success"`
+		Embedding  []float64 `json:"embedding"`
+		Message    string    `json:"message"`
+		Model      string    `json:"model"`
+		TokensUsed uint32    `json:"tokens_used"`
 	}
 
-	if err := c.callJSONRPC("llm.embedding", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "llm.embedding", params, &result); err != nil {
 		return nil, err
 	}
 
 	return &LLMEmbeddingResponse{
-		Success:   result.Success,
-		Embedding: result.Embedding,
-		Message:   result.Message,
+		Success:    result.Success,
+		Embedding:  result.Embedding,
+		Message:    result.Message,
+		Model:      result.Model,
+		TokensUsed: result.TokensUsed,
 	}, nil
 }
 
+// BatchEmbeddingLLM generates embeddings for many texts, in sub-batches
+func (c *HTTPClient) BatchEmbeddingLLM(ctx context.Context, name string, texts []string, opts ...EmbeddingOption) (*LLMBatchEmbeddingResponse, error) {
+	return batchEmbed(ctx, name, texts, opts, func(ctx context.Context, name string, texts []string) (*LLMBatchEmbeddingResponse, error) {
+		params := map[string]interface{}{
+			"name":  name,
+			"texts": texts,
+		}
+
+		var result struct {
+			Success bool `json:"success"`
+			Results []struct {
+				Embedding []float64 `json:"embedding"`
+				Error     string    `json:"error"`
+			} `json:"results"`
+			Message    string `json:"message"`
+			Model      string `json:"model"`
+			TokensUsed uint32 `json:"tokens_used"`
+		}
+
+		if err := c.callJSONRPC(ctx, "llm.batch_embedding", params, &result); err != nil {
+			return nil, err
+		}
+
+		results := make([]EmbeddingResult, len(result.Results))
+		for i, r := range result.Results {
+			if r.Error != "" {
+				results[i].Err = errors.New(r.Error)
+				continue
+			}
+			results[i].Embedding = r.Embedding
+		}
+		return &LLMBatchEmbeddingResponse{
+			Success:    result.Success,
+			Message:    result.Message,
+			Results:    results,
+			Model:      result.Model,
+			TokensUsed: result.TokensUsed,
+		}, nil
+	})
+}
+
+// StreamLLM generates text from a prompt, delivering it incrementally
+func (c *HTTPClient) StreamLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMStream, error) {
+	req, err := streamRequest(name, prompt, opts)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{
+		"name":   req.Name,
+		"prompt": req.Prompt,
+	}
+	addGenerationParams(params, req.Options)
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.openStream(ctx, "llm.stream", params)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	recv := func() (*pb.StreamLLMResponse, error) {
+		var result struct {
+			Success bool   `json:"success"`
+			Chunk   string `json:"chunk"`
+			Done    bool   `json:"done"`
+			Error   string `json:"error"`
+			Message string `json:"message"`
+		}
+		if err := stream.next(&result); err != nil {
+			return nil, err
+		}
+		if result.Error == "" {
+			result.Error = result.Message
+		}
+		return &pb.StreamLLMResponse{
+			Success: result.Success,
+			Chunk:   result.Chunk,
+			Done:    result.Done,
+			Error:   result.Error,
+		}, nil
+	}
+
+	return newLLMStream(ctx, func() {
+		cancel()
+		stream.close()
+	}, recv), nil
+}
+
 // PingLLM checks if an LLM client is alive
 func (c *HTTPClient) PingLLM(ctx context.Context, name string) (*LLMResponse, error) {
 	params := map[string]interface{}{
@@ -410,7 +1309,7 @@ func (c *HTTPClient) PingLLM(ctx context.Context, name string) (*LLMResponse, er
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("llm.ping", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "llm.ping", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -431,7 +1330,7 @@ func (c *HTTPClient) CloseLLM(ctx context.Context, name string) (*LLMResponse, e
 		Message string `json:"message"`
 	}
 
-	if err := c.callJSONRPC("llm.close", params, &result); err != nil {
+	if err := c.callJSONRPC(ctx, "llm.close", params, &result); err != nil {
 		return nil, err
 	}
 
@@ -441,6 +1340,45 @@ func (c *HTTPClient) CloseLLM(ctx context.Context, name string) (*LLMResponse, e
 	}, nil
 }
 
+// llmGenerateResult is the JSON-RPC result of llm.generate and llm.chat
+type llmGenerateResult struct {
+	Success      bool   `json:"success"`
+	Text         string `json:"text"`
+	Message      string `json:"message"`
+	TokensUsed   uint32 `json:"tokens_used"`
+	FinishReason string `json:"finish_reason"`
+	Model        string `json:"model"`
+	ToolCalls    []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		// JSON text, or the arguments object itself
+		Arguments json.RawMessage `json:"arguments"`
+	} `json:"tool_calls"`
+}
+
+func (r *llmGenerateResult) toResponse() *LLMGenerateResponse {
+	var calls []*pb.ToolCall
+	for _, call := range r.ToolCalls {
+		args := string(call.Arguments)
+		var text string
+		if json.Unmarshal(call.Arguments, &text) == nil {
+			args = text
+		} else if args == "null" {
+			args = ""
+		}
+		calls = append(calls, &pb.ToolCall{Id: call.ID, Name: call.Name, ArgumentsJson: args})
+	}
+	return &LLMGenerateResponse{
+		Success:      r.Success,
+		Text:         r.Text,
+		Message:      r.Message,
+		TokensUsed:   r.TokensUsed,
+		FinishReason: r.FinishReason,
+		Model:        r.Model,
+		ToolCalls:    toolCallsFromProto(calls),
+	}
+}
+
 type httpError struct {
 	code int
 	msg  string
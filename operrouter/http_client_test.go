@@ -0,0 +1,140 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCallJSONRPCContext(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ClientOption
+		ctx  func() (context.Context, context.CancelFunc)
+		want error
+	}{
+		{
+			"cancelled before the call",
+			nil,
+			func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			context.Canceled,
+		},
+		{
+			"cancelled during the call",
+			nil,
+			func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(20*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			context.Canceled,
+		},
+		{
+			"caller deadline",
+			nil,
+			func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 20*time.Millisecond)
+			},
+			context.DeadlineExceeded,
+		},
+		{
+			"client timeout",
+			[]ClientOption{WithTimeout(20 * time.Millisecond)},
+			func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The server answers only once the client has given up
+			release := make(chan struct{})
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-release:
+				}
+			}))
+			defer srv.Close()
+			defer close(release)
+
+			client, err := NewHTTPWithOptions(srv.URL, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			start := time.Now()
+			_, err = client.Ping(ctx)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Ping error = %v, want %v", err, tt.want)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("Ping returned after %s, want it to stop with ctx", elapsed)
+			}
+		})
+	}
+}
+
+func TestCallJSONRPCTimeoutHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []ClientOption
+		timeout time.Duration // of the caller's ctx; 0 for none
+		max     int64         // largest expected header value; 0 for no header
+	}{
+		{"no deadline", nil, 0, 0},
+		{"caller deadline", nil, 5 * time.Second, 5000},
+		{"client timeout", []ClientOption{WithTimeout(2 * time.Second)}, 0, 2000},
+		{"caller deadline wins over client timeout", []ClientOption{WithTimeout(time.Minute)}, 3 * time.Second, 3000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var header string
+			var sent bool
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header.Get(timeoutHeader)
+				_, sent = r.Header[http.CanonicalHeaderKey(timeoutHeader)]
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"status":"ok"}}`))
+			}))
+			defer srv.Close()
+
+			client, err := NewHTTPWithOptions(srv.URL, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			if _, err := client.Ping(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.max == 0 {
+				if sent {
+					t.Errorf("%s = %q, want no header without a deadline", timeoutHeader, header)
+				}
+				return
+			}
+			ms, err := strconv.ParseInt(header, 10, 64)
+			if err != nil || ms < 1 || ms > tt.max || ms < tt.max-1000 {
+				t.Errorf("%s = %q, want about %d", timeoutHeader, header, tt.max)
+			}
+		})
+	}
+}
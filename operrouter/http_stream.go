@@ -0,0 +1,189 @@
+package operrouter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+// httpStream reads a streamed JSON-RPC reply. The server answers with either
+// server-sent events ("data: {...}" records) or JSON lines; every record is a
+// JSON-RPC response whose result is one message of the stream. A complete
+// stream ends with a record whose result has "done": true.
+type httpStream struct {
+	ctx    context.Context
+	body   io.ReadCloser
+	reader *bufio.Reader
+	sse    bool
+	single *jsonrpcResponse
+	eof    bool
+}
+
+// openStream posts a JSON-RPC request and returns the streamed reply.
+// The client's default timeout is not applied: a stream lives until ctx ends.
+func (c *HTTPClient) openStream(ctx context.Context, method string, params interface{}) (*httpStream, error) {
+	req := jsonrpcRequest{JSONRPC: "2.0", Method: method, Params: params, ID: 1}
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := c.newRequest(ctx, reqBytes)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", "text/event-stream, application/x-ndjson, application/json")
+
+	// A fixed client timeout would cut long streams short
+	streamHTTP := *c.HTTP
+	streamHTTP.Timeout = 0
+
+	resp, err := streamHTTP.Do(httpReq)
+	if err != nil {
+		return nil, contextError(ctx, fmt.Errorf("http request failed: %w", err))
+	}
+	if resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("http request failed: %s", resp.Status)
+	}
+
+	s := &httpStream{
+		ctx:    ctx,
+		body:   resp.Body,
+		reader: bufio.NewReader(resp.Body),
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/event-stream":
+		s.sse = true
+	case "application/json":
+		// Servers that cannot stream, or that reject the call, answer with a
+		// single JSON-RPC response
+		var rpcResp jsonrpcResponse
+		err := json.NewDecoder(s.reader).Decode(&rpcResp)
+		resp.Body.Close()
+		if err != nil {
+			return nil, contextError(ctx, fmt.Errorf("failed to decode response: %w", err))
+		}
+		if rpcResp.Error != nil {
+			return nil, &httpError{code: rpcResp.Error.Code, msg: rpcResp.Error.Message}
+		}
+		s.single = &rpcResp
+	}
+
+	return s, nil
+}
+
+// next decodes the result of the next record into v. It returns io.EOF after
+// the record marked done, and io.ErrUnexpectedEOF when the body ends before it.
+func (s *httpStream) next(v interface{}) error {
+	if s.eof {
+		return io.EOF
+	}
+	if s.single != nil {
+		s.eof = true
+		return decodeStreamResult(s.single, v)
+	}
+
+	var payload []byte
+	for {
+		var err error
+		if s.sse {
+			payload, err = s.readEvent()
+		} else {
+			payload, err = s.readLine()
+		}
+		if err != nil {
+			if err == io.EOF {
+				// A complete stream ends with a done record, not the body
+				err = io.ErrUnexpectedEOF
+			}
+			return contextError(s.ctx, err)
+		}
+		if len(payload) > 0 {
+			break
+		}
+	}
+
+	var rpcResp jsonrpcResponse
+	if err := json.Unmarshal(payload, &rpcResp); err != nil {
+		return fmt.Errorf("failed to decode stream record: %w", err)
+	}
+	if err := decodeStreamResult(&rpcResp, v); err != nil {
+		return err
+	}
+	s.eof = recordDone(&rpcResp)
+	return nil
+}
+
+// recordDone reports whether a record's result is the last of the stream
+func recordDone(rpcResp *jsonrpcResponse) bool {
+	var result struct {
+		Done bool `json:"done"`
+	}
+	return json.Unmarshal(*rpcResp.Result, &result) == nil && result.Done
+}
+
+// close releases the underlying connection
+func (s *httpStream) close() error {
+	return s.body.Close()
+}
+
+// readLine returns the next non-empty JSON line
+func (s *httpStream) readLine() ([]byte, error) {
+	line, err := s.reader.ReadBytes('\n')
+	line = bytes.TrimSpace(line)
+	if len(line) > 0 {
+		return line, nil
+	}
+	return nil, err
+}
+
+// readEvent returns the data of the next server-sent event
+func (s *httpStream) readEvent() ([]byte, error) {
+	var data []byte
+	for {
+		line, err := s.reader.ReadString('\n')
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				return data, nil
+			}
+		case strings.HasPrefix(line, "data:"):
+			if len(data) > 0 {
+				data = append(data, '\n')
+			}
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " ")...)
+		}
+		// Comments, event names and ids carry nothing we need
+
+		if err != nil {
+			if len(data) > 0 && err == io.EOF {
+				return data, nil
+			}
+			return nil, err
+		}
+	}
+}
+
+// decodeStreamResult unmarshals one JSON-RPC record of a stream into v
+func decodeStreamResult(rpcResp *jsonrpcResponse, v interface{}) error {
+	if rpcResp.Error != nil {
+		return &httpError{code: rpcResp.Error.Code, msg: rpcResp.Error.Message}
+	}
+	if rpcResp.Result == nil {
+		return fmt.Errorf("stream record has no result")
+	}
+	if err := unmarshalResult(*rpcResp.Result, v); err != nil {
+		return fmt.Errorf("failed to unmarshal result: %w", err)
+	}
+	return nil
+}
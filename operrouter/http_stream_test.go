@@ -0,0 +1,213 @@
+package operrouter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHTTPStreamNext(t *testing.T) {
+	tests := []struct {
+		name string
+		sse  bool
+		body string
+		want []string
+		err  string // error after the records in want, "" for io.EOF
+	}{
+		{
+			"json lines",
+			false,
+			`{"result":{"chunk":"a"}}` + "\n\n" + `{"result":{"chunk":"b"}}` + "\r\n" + `{"result":{"chunk":"c","done":true}}`,
+			[]string{"a", "b", "c"},
+			"",
+		},
+		{
+			"json lines stop at done",
+			false,
+			`{"result":{"chunk":"a","done":true}}` + "\n" + `{"result":{"chunk":"ignored"}}` + "\n",
+			[]string{"a"},
+			"",
+		},
+		{
+			"server-sent events",
+			true,
+			": keep-alive\n\nevent: message\nid: 1\ndata: {\"result\":{\"chunk\":\"a\"}}\n\n" +
+				"data:{\"result\":{\"chunk\":\"b\",\"done\":true}}\r\n\r\n",
+			[]string{"a", "b"},
+			"",
+		},
+		{
+			"multi-line event data",
+			true,
+			"data: {\"result\":\ndata: {\"chunk\":\"a\",\"done\":true}}\n\n",
+			[]string{"a"},
+			"",
+		},
+		{
+			"event without trailing blank line",
+			true,
+			"data: {\"result\":{\"chunk\":\"a\",\"done\":true}}",
+			[]string{"a"},
+			"",
+		},
+		{
+			"truncated json lines",
+			false,
+			`{"result":{"chunk":"a"}}` + "\n" + `{"result":{"chunk":"b"}}` + "\n",
+			[]string{"a", "b"},
+			"unexpected EOF",
+		},
+		{
+			"truncated server-sent events",
+			true,
+			"data: {\"result\":{\"chunk\":\"a\"}}\n\n",
+			[]string{"a"},
+			"unexpected EOF",
+		},
+		{"empty body", false, "\n\n", nil, "unexpected EOF"},
+		{
+			"error record",
+			false,
+			`{"result":{"chunk":"a"}}` + "\n" + `{"error":{"code":-32000,"message":"model overloaded"}}` + "\n",
+			[]string{"a"},
+			"model overloaded",
+		},
+		{"record without result", false, `{"jsonrpc":"2.0"}` + "\n", nil, "stream record has no result"},
+		{"malformed record", true, "data: {oops\n\n", nil, "failed to decode stream record"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &httpStream{
+				ctx:    context.Background(),
+				body:   io.NopCloser(strings.NewReader(tt.body)),
+				reader: bufio.NewReader(strings.NewReader(tt.body)),
+				sse:    tt.sse,
+			}
+
+			var got []string
+			var err error
+			for {
+				var result struct {
+					Chunk string `json:"chunk"`
+				}
+				if err = s.next(&result); err != nil {
+					break
+				}
+				got = append(got, result.Chunk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("records = %q, want %q", got, tt.want)
+			}
+			if tt.err == "" {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("error = %v, want io.EOF", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestHTTPStreamLLM(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        []string
+		err         string
+	}{
+		{
+			"server-sent events",
+			"text/event-stream",
+			"data: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"success\":true,\"chunk\":\"Hel\"}}\n\n" +
+				"data: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"success\":true,\"chunk\":\"lo\",\"done\":true}}\n\n" +
+				"data: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"success\":true,\"chunk\":\"ignored\"}}\n\n",
+			[]string{"Hel", "lo"},
+			"",
+		},
+		{
+			"json lines",
+			"application/x-ndjson; charset=utf-8",
+			`{"result":{"success":true,"chunk":"a"}}` + "\n" + `{"result":{"success":true,"chunk":"b","done":true}}` + "\n",
+			[]string{"a", "b"},
+			"",
+		},
+		{
+			"single response",
+			"application/json",
+			`{"jsonrpc":"2.0","id":1,"result":{"success":true,"chunk":"all","done":true}}`,
+			[]string{"all"},
+			"",
+		},
+		{
+			"rejected call",
+			"application/json",
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`,
+			nil,
+			"method not found",
+		},
+		{
+			"connection dropped before done",
+			"text/event-stream",
+			"data: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"success\":true,\"chunk\":\"Hel\"}}\n\n",
+			[]string{"Hel"},
+			"stream llm failed: unexpected EOF",
+		},
+		{
+			"failure chunk",
+			"application/x-ndjson",
+			`{"result":{"success":true,"chunk":"a"}}` + "\n" + `{"result":{"success":false,"message":"context length exceeded"}}` + "\n",
+			[]string{"a"},
+			"stream llm failed: context length exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req jsonrpcRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "llm.stream" {
+					t.Errorf("request %+v, %v: want llm.stream", req, err)
+				}
+				w.Header().Set("Content-Type", tt.contentType)
+				io.WriteString(w, tt.body)
+			}))
+			defer srv.Close()
+
+			client, err := NewHTTPWithOptions(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stream, err := client.StreamLLM(context.Background(), "llm", "hi")
+			var got []string
+			if err == nil {
+				for chunk, chunkErr := range stream.Chunks() {
+					if chunkErr != nil {
+						err = chunkErr
+						break
+					}
+					got = append(got, chunk.Text)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("chunks = %q, want %q", got, tt.want)
+			}
+			if tt.err == "" && err != nil {
+				t.Errorf("error = %v, want none", err)
+			}
+			if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Errorf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package operrouter
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultInsertBatchSize is the number of rows sent per call by
+// BulkInsertDataSource unless WithBatchSize is given
+const DefaultInsertBatchSize = 500
+
+// InsertOption configures BulkInsertDataSource
+type InsertOption func(*insertOptions)
+
+// insertOptions holds the settings collected from InsertOption values
+type insertOptions struct {
+	batchSize int
+}
+
+// WithBatchSize sets how many rows are sent per call.
+// A size of 0 or less sends all rows in a single call.
+func WithBatchSize(size int) InsertOption {
+	return func(o *insertOptions) {
+		o.batchSize = size
+	}
+}
+
+// insertBatchFunc inserts one batch of rows into table
+type insertBatchFunc func(ctx context.Context, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error)
+
+// bulkInsert splits rows into batches and inserts them in order, stopping at
+// the first batch that fails. The response counts the rows inserted so far.
+func bulkInsert(ctx context.Context, table string, rows []map[string]interface{}, opts []InsertOption, insertBatch insertBatchFunc) (*DataSourceInsertResponse, error) {
+	o := insertOptions{batchSize: DefaultInsertBatchSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	batchSize := o.batchSize
+	if batchSize <= 0 || batchSize > len(rows) {
+		batchSize = len(rows)
+	}
+
+	result := &DataSourceInsertResponse{Success: true}
+	for start := 0; start < len(rows); start += batchSize {
+		end := min(start+batchSize, len(rows))
+		resp, err := insertBatch(ctx, table, rows[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("bulk insert failed after %d rows: %w", result.InsertedRows, err)
+		}
+		result.InsertedRows += resp.InsertedRows
+		result.Message = resp.Message
+		if !resp.Success {
+			result.Success = false
+			return result, nil
+		}
+	}
+	return result, nil
+}
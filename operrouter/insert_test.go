@@ -0,0 +1,150 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBulkInsert(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        []map[string]interface{}
+		opts        []InsertOption
+		failBatch   int // 1-based batch the server rejects, 0 for none
+		wantBatches [][]int
+		want        DataSourceInsertResponse
+	}{
+		{"no rows", nil, nil, 0, nil, DataSourceInsertResponse{Success: true}},
+		{
+			"default size",
+			[]map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 3}},
+			nil, 0,
+			[][]int{{1, 2, 3}},
+			DataSourceInsertResponse{Success: true, InsertedRows: 3, Message: "batch 1"},
+		},
+		{
+			"exact batches",
+			[]map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}},
+			[]InsertOption{WithBatchSize(2)}, 0,
+			[][]int{{1, 2}, {3, 4}},
+			DataSourceInsertResponse{Success: true, InsertedRows: 4, Message: "batch 2"},
+		},
+		{
+			"short last batch",
+			[]map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}, {"id": 5}},
+			[]InsertOption{WithBatchSize(2)}, 0,
+			[][]int{{1, 2}, {3, 4}, {5}},
+			DataSourceInsertResponse{Success: true, InsertedRows: 5, Message: "batch 3"},
+		},
+		{
+			"size larger than rows",
+			[]map[string]interface{}{{"id": 1}, {"id": 2}},
+			[]InsertOption{WithBatchSize(10)}, 0,
+			[][]int{{1, 2}},
+			DataSourceInsertResponse{Success: true, InsertedRows: 2, Message: "batch 1"},
+		},
+		{
+			"size zero sends one batch",
+			[]map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 3}},
+			[]InsertOption{WithBatchSize(0)}, 0,
+			[][]int{{1, 2, 3}},
+			DataSourceInsertResponse{Success: true, InsertedRows: 3, Message: "batch 1"},
+		},
+		{
+			"stops at rejected batch",
+			[]map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}, {"id": 5}, {"id": 6}},
+			[]InsertOption{WithBatchSize(2)}, 2,
+			[][]int{{1, 2}, {3, 4}},
+			DataSourceInsertResponse{Success: false, InsertedRows: 2, Message: "batch 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches [][]int
+			insertBatch := func(ctx context.Context, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error) {
+				if table != "users" {
+					t.Errorf("table = %q, want users", table)
+				}
+				ids := make([]int, len(rows))
+				for i, row := range rows {
+					ids[i] = row["id"].(int)
+				}
+				batches = append(batches, ids)
+				n := len(batches)
+				resp := &DataSourceInsertResponse{Success: n != tt.failBatch, Message: fmt.Sprintf("batch %d", n)}
+				if resp.Success {
+					resp.InsertedRows = uint64(len(rows))
+				}
+				return resp, nil
+			}
+
+			got, err := bulkInsert(context.Background(), "users", tt.rows, tt.opts, insertBatch)
+			if err != nil {
+				t.Fatalf("bulkInsert: %v", err)
+			}
+			if !reflect.DeepEqual(batches, tt.wantBatches) {
+				t.Errorf("batches = %v, want %v", batches, tt.wantBatches)
+			}
+			if *got != tt.want {
+				t.Errorf("bulkInsert = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBulkInsertError(t *testing.T) {
+	calls := 0
+	insertBatch := func(ctx context.Context, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error) {
+		calls++
+		if calls == 3 {
+			return nil, errors.New("connection reset")
+		}
+		return &DataSourceInsertResponse{Success: true, InsertedRows: uint64(len(rows))}, nil
+	}
+
+	rows := []map[string]interface{}{
+		{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}, {"id": 5},
+		{"id": 6}, {"id": 7}, {"id": 8}, {"id": 9}, {"id": 10},
+	}
+	_, err := bulkInsert(context.Background(), "users", rows, []InsertOption{WithBatchSize(3)}, insertBatch)
+	if err == nil || !strings.Contains(err.Error(), "after 6 rows: connection reset") {
+		t.Errorf("bulkInsert error = %v, want it to report 6 rows inserted", err)
+	}
+	if calls != 3 {
+		t.Errorf("insertBatch called %d times, want 3", calls)
+	}
+}
+
+func TestInsertRequest(t *testing.T) {
+	req, err := insertRequest("db", "tx1", "users", []map[string]interface{}{
+		{"id": 1, "name": "a"},
+		{"id": 2, "name": nil},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Name != "db" || req.Table != "users" || req.TransactionId != "tx1" {
+		t.Errorf("insertRequest = %v, want name, table and transaction set", req)
+	}
+	var got []map[string]interface{}
+	for _, row := range req.Rows {
+		got = append(got, RowToMap(row))
+	}
+	want := []map[string]interface{}{
+		{"id": int64(1), "name": "a"},
+		{"id": int64(2), "name": nil},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %#v, want %#v", got, want)
+	}
+
+	_, err = insertRequest("db", "", "users", []map[string]interface{}{{"id": 1}, {"bad": make(chan int)}})
+	if err == nil || !strings.HasPrefix(err.Error(), "row 1: column bad:") {
+		t.Errorf("insertRequest error = %v, want it to name row 1 and column bad", err)
+	}
+}
@@ -2,6 +2,7 @@ package operrouter
 
 import (
 	"context"
+	"database/sql"
 )
 
 // Client is the unified interface for all transport backends (HTTP, gRPC, FFI)
@@ -23,35 +24,101 @@ type Client interface {
 	// CreateDataSource creates a new DataSource connection
 	CreateDataSource(ctx context.Context, name string, config map[string]interface{}) (*DataSourceResponse, error)
 
-	// QueryDataSource executes a read query on a DataSource
-	QueryDataSource(ctx context.Context, name string, query string) (*DataSourceQueryResponse, error)
+	// QueryDataSource executes a read query on a DataSource.
+	// args are bound to the query's placeholders (see Placeholder); pass
+	// sql.Named values for named arguments.
+	QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceQueryResponse, error)
 
-	// ExecuteDataSource executes a write operation on a DataSource
-	ExecuteDataSource(ctx context.Context, name string, query string) (*DataSourceResponse, error)
+	// StreamQueryDataSource executes a read query and returns a cursor that
+	// fetches the rows pageSize at a time (0 lets the server choose). The
+	// cursor ends when the rows run out, when ctx is cancelled or when it is closed.
+	StreamQueryDataSource(ctx context.Context, name string, pageSize uint32, query string, args ...interface{}) (*RowCursor, error)
+
+	// ExecuteDataSource executes a write operation on a DataSource.
+	// args are bound the same way as for QueryDataSource.
+	ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceExecuteResponse, error)
 
 	// InsertDataSource inserts data into a DataSource
 	InsertDataSource(ctx context.Context, name string, data map[string]interface{}) (*DataSourceResponse, error)
 
+	// BulkInsertDataSource inserts rows into a table or collection, sending
+	// them in batches (see WithBatchSize). It stops at the first failed batch.
+	BulkInsertDataSource(ctx context.Context, name string, table string, rows []map[string]interface{}, opts ...InsertOption) (*DataSourceInsertResponse, error)
+
+	// BeginTx starts a transaction on a DataSource. opts may be nil; the
+	// transaction is rolled back if ctx is cancelled before Commit.
+	BeginTx(ctx context.Context, name string, opts *sql.TxOptions) (*Tx, error)
+
 	// PingDataSource checks if a DataSource is alive
 	PingDataSource(ctx context.Context, name string) (*DataSourceResponse, error)
 
 	// CloseDataSource closes a DataSource connection
 	CloseDataSource(ctx context.Context, name string) (*DataSourceResponse, error)
 
+	// ListTables lists the tables, views or collections of a DataSource in
+	// schema, or in the default schema when schema is empty
+	ListTables(ctx context.Context, name string, schema string) (*ListTablesResponse, error)
+
+	// DescribeTable returns the columns of a table or collection; for
+	// document DataSources the fields are inferred from sampled documents
+	DescribeTable(ctx context.Context, name string, schema string, table string) (*DescribeTableResponse, error)
+
+	// Kafka operations
+
+	// PublishKafka publishes records to a Kafka DataSource, sending them in
+	// batches (see WithPublishBatchSize) and waiting for the acks set with WithAcks
+	PublishKafka(ctx context.Context, name string, records []KafkaRecord, opts ...PublishOption) (*KafkaPublishResponse, error)
+
+	// SubscribeKafka consumes topics of a Kafka DataSource until ctx is
+	// cancelled or the subscription is closed
+	SubscribeKafka(ctx context.Context, name string, opts KafkaSubscribeOptions) (*KafkaSubscription, error)
+
+	// MongoDB operations
+
+	// FindMongo returns the documents of a MongoDB collection matching filter.
+	// A nil filter matches every document; opts may be nil.
+	FindMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, opts *MongoFindOptions) (*MongoFindResponse, error)
+
+	// InsertMongo inserts documents into a MongoDB collection, keeping
+	// nested maps and slices as sub-documents and arrays
+	InsertMongo(ctx context.Context, name string, collection string, documents []map[string]interface{}) (*MongoInsertResponse, error)
+
+	// UpdateMongo applies an update document such as {"$set": {...}} to the
+	// first document matching filter, or every match with opts.Many.
+	// filter must not be nil; pass an empty map to match every document.
+	UpdateMongo(ctx context.Context, name string, collection string, filter, update map[string]interface{}, opts *MongoUpdateOptions) (*MongoUpdateResponse, error)
+
+	// DeleteMongo deletes the first document matching filter, or every match
+	// when many is set. filter must not be nil; pass an empty map to match
+	// every document.
+	DeleteMongo(ctx context.Context, name string, collection string, filter map[string]interface{}, many bool) (*MongoDeleteResponse, error)
+
 	// LLM operations
 
 	// CreateLLM creates a new LLM client
 	CreateLLM(ctx context.Context, name string, config map[string]interface{}) (*LLMResponse, error)
 
-	// GenerateLLM generates text from a prompt
-	GenerateLLM(ctx context.Context, name string, prompt string) (*LLMGenerateResponse, error)
+	// GenerateLLM generates text from a prompt. Options such as
+	// WithTemperature or WithJSONMode apply to this call only.
+	GenerateLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMGenerateResponse, error)
 
-	// ChatLLM performs a chat conversation with message history
-	ChatLLM(ctx context.Context, name string, messages []map[string]interface{}) (*LLMGenerateResponse, error)
+	// ChatLLM performs a chat conversation with message history.
+	// Messages with an unknown role are rejected. Declare tools with WithTools;
+	// the calls the model requests come back in ToolCalls.
+	ChatLLM(ctx context.Context, name string, messages []ChatMessage, opts ...LLMOption) (*LLMGenerateResponse, error)
 
 	// EmbeddingLLM generates embeddings for text
 	EmbeddingLLM(ctx context.Context, name string, text string) (*LLMEmbeddingResponse, error)
 
+	// BatchEmbeddingLLM generates embeddings for many texts, returned in input
+	// order with per-text errors. Large inputs are split per WithEmbeddingBatchSize
+	// and embedded concurrently up to WithEmbeddingConcurrency.
+	BatchEmbeddingLLM(ctx context.Context, name string, texts []string, opts ...EmbeddingOption) (*LLMBatchEmbeddingResponse, error)
+
+	// StreamLLM generates text from a prompt, delivering it incrementally.
+	// The stream ends when it completes, when ctx is cancelled or when it is closed.
+	StreamLLM(ctx context.Context, name string, prompt string, opts ...LLMOption) (*LLMStream, error)
+
 	// PingLLM checks if an LLM client is alive
 	PingLLM(ctx context.Context, name string) (*LLMResponse, error)
 
@@ -93,10 +160,35 @@ type DataSourceResponse struct {
 	Message string
 }
 
+type DataSourceExecuteResponse struct {
+	Success bool
+	// AffectedRows is the number of rows changed by the statement
+	AffectedRows uint64
+	// LastInsertID is the generated id of the inserted row, nil when the
+	// driver does not report one (PostgreSQL needs RETURNING instead)
+	LastInsertID *int64
+	Message      string
+
+	// Rows holds what the statement returned, such as RETURNING rows or the
+	// reply of a Redis command
+	Rows []map[string]interface{}
+}
+
+type DataSourceInsertResponse struct {
+	Success bool
+	// InsertedRows is the number of rows the server reports as inserted
+	InsertedRows uint64
+	Message      string
+}
+
 type DataSourceQueryResponse struct {
 	Success bool
 	Rows    []map[string]interface{}
 	Message string
+
+	// Columns lists the column names in select-list order, when the server
+	// reports them
+	Columns []string
 }
 
 // LLM response types
@@ -110,20 +202,25 @@ type LLMGenerateResponse struct {
 	Success bool
 	Text    string
 	Message string
+
+	// TokensUsed is the total token count reported by the provider, 0 if unknown
+	TokensUsed uint32
+	// FinishReason explains why generation stopped, e.g. "stop" or "length"
+	FinishReason string
+	// Model is the model that served the request
+	Model string
+	// ToolCalls are the calls requested by the model, with FinishReason
+	// typically "tool_calls". Run them and answer each with ToolMessage.
+	ToolCalls []ToolCall
 }
 
 type LLMEmbeddingResponse struct {
 	Success   bool
 	Embedding []float64
 	Message   string
-}
-
-// ClientOption configures a client
-type ClientOption func(interface{})
 
-// WithTimeout sets the operation timeout
-func WithTimeout(timeout int) ClientOption {
-	return func(c interface{}) {
-		// Implementation varies by backend
-	}
+	// Model is the model that produced the embedding
+	Model string
+	// TokensUsed is the token count reported by the provider, 0 if unknown
+	TokensUsed uint32
 }
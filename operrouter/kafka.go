@@ -0,0 +1,338 @@
+package operrouter
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sort"
+	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// DefaultPublishBatchSize is the number of records sent per call by
+// PublishKafka unless WithPublishBatchSize is given
+const DefaultPublishBatchSize = 500
+
+// KafkaHeader is a record header
+type KafkaHeader struct {
+	Key   string
+	Value []byte
+}
+
+// KafkaRecord is a message to publish
+type KafkaRecord struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers []KafkaHeader
+	// Partition pins the record to a partition; nil lets the partitioner choose
+	Partition *int32
+	// Timestamp defaults to the time the producer sends the record
+	Timestamp time.Time
+}
+
+// KafkaAcks selects the acknowledgement a publish waits for
+type KafkaAcks int
+
+const (
+	// KafkaAcksDefault uses the DataSource's producer setting
+	KafkaAcksDefault KafkaAcks = iota
+	// KafkaAcksNone does not wait for the broker
+	KafkaAcksNone
+	// KafkaAcksLeader waits for the partition leader
+	KafkaAcksLeader
+	// KafkaAcksAll waits for every in-sync replica
+	KafkaAcksAll
+)
+
+// KafkaDelivery reports where a published record was written
+type KafkaDelivery struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	// Error is set when this record was not delivered
+	Error string
+}
+
+// KafkaPublishResponse holds one delivery report per record, in order
+type KafkaPublishResponse struct {
+	Success    bool
+	Deliveries []KafkaDelivery
+	Message    string
+}
+
+// PublishOption configures PublishKafka
+type PublishOption func(*publishOptions)
+
+// publishOptions holds the settings collected from PublishOption values
+type publishOptions struct {
+	acks      KafkaAcks
+	batchSize int
+}
+
+// WithAcks sets the acknowledgement every batch waits for
+func WithAcks(acks KafkaAcks) PublishOption {
+	return func(o *publishOptions) {
+		o.acks = acks
+	}
+}
+
+// WithPublishBatchSize sets how many records are sent per call.
+// A size of 0 or less sends all records in a single call.
+func WithPublishBatchSize(size int) PublishOption {
+	return func(o *publishOptions) {
+		o.batchSize = size
+	}
+}
+
+// publishBatchFunc publishes one batch of records
+type publishBatchFunc func(ctx context.Context, req *pb.KafkaPublishRequest) (*pb.KafkaPublishResponse, error)
+
+// publishKafka splits records into batches and publishes them in order,
+// stopping at the first batch that fails
+func publishKafka(ctx context.Context, name string, records []KafkaRecord, opts []PublishOption, publishBatch publishBatchFunc) (*KafkaPublishResponse, error) {
+	o := publishOptions{batchSize: DefaultPublishBatchSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	acks, err := kafkaAcksToProto(o.acks)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := o.batchSize
+	if batchSize <= 0 || batchSize > len(records) {
+		batchSize = len(records)
+	}
+
+	result := &KafkaPublishResponse{Success: true}
+	for start := 0; start < len(records); start += batchSize {
+		batch := records[start:min(start+batchSize, len(records))]
+		req := &pb.KafkaPublishRequest{
+			Name:    name,
+			Records: make([]*pb.KafkaRecord, len(batch)),
+			Acks:    acks,
+		}
+		for i := range batch {
+			req.Records[i] = kafkaRecordToProto(&batch[i])
+		}
+
+		resp, err := publishBatch(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("publish kafka failed after %d records: %w", len(result.Deliveries), err)
+		}
+		for i, report := range resp.Reports {
+			delivery := KafkaDelivery{
+				Partition: report.Partition,
+				Offset:    report.Offset,
+				Error:     report.Error,
+			}
+			if i < len(batch) {
+				delivery.Topic = batch[i].Topic
+			}
+			result.Deliveries = append(result.Deliveries, delivery)
+		}
+		result.Message = resp.Error
+		if !resp.Success {
+			result.Success = false
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+// kafkaAcksToProto maps KafkaAcks onto the proto enum
+func kafkaAcksToProto(acks KafkaAcks) (pb.KafkaAcks, error) {
+	switch acks {
+	case KafkaAcksDefault:
+		return pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED, nil
+	case KafkaAcksNone:
+		return pb.KafkaAcks_KAFKA_ACKS_NONE, nil
+	case KafkaAcksLeader:
+		return pb.KafkaAcks_KAFKA_ACKS_LEADER, nil
+	case KafkaAcksAll:
+		return pb.KafkaAcks_KAFKA_ACKS_ALL, nil
+	default:
+		return 0, fmt.Errorf("publish kafka failed: unknown acks %d", acks)
+	}
+}
+
+// kafkaRecordToProto converts a record for KafkaPublishRequest
+func kafkaRecordToProto(r *KafkaRecord) *pb.KafkaRecord {
+	record := &pb.KafkaRecord{
+		Topic:     r.Topic,
+		Key:       r.Key,
+		Value:     r.Value,
+		Headers:   kafkaHeadersToProto(r.Headers),
+		Partition: r.Partition,
+	}
+	if !r.Timestamp.IsZero() {
+		ts := r.Timestamp.UnixMilli()
+		record.TimestampMs = &ts
+	}
+	return record
+}
+
+// kafkaHeadersToProto converts record headers
+func kafkaHeadersToProto(headers []KafkaHeader) []*pb.KafkaHeader {
+	if len(headers) == 0 {
+		return nil
+	}
+	protoHeaders := make([]*pb.KafkaHeader, len(headers))
+	for i, h := range headers {
+		protoHeaders[i] = &pb.KafkaHeader{Key: h.Key, Value: h.Value}
+	}
+	return protoHeaders
+}
+
+// KafkaSubscribeOptions selects what SubscribeKafka consumes
+type KafkaSubscribeOptions struct {
+	Topics []string
+	// GroupID joins a consumer group; required to commit offsets
+	GroupID string
+	// FromEarliest starts from the oldest message when the group has no
+	// committed offset, instead of only new messages
+	FromEarliest bool
+	// AutoCommit lets the consumer commit offsets periodically; otherwise
+	// call KafkaSubscription.Commit
+	AutoCommit bool
+}
+
+// toProto builds the subscribe request
+func (o *KafkaSubscribeOptions) toProto(name string) (*pb.KafkaSubscribeRequest, error) {
+	if len(o.Topics) == 0 {
+		return nil, fmt.Errorf("subscribe kafka failed: at least one topic is required")
+	}
+	return &pb.KafkaSubscribeRequest{
+		Name:         name,
+		Topics:       o.Topics,
+		GroupId:      o.GroupID,
+		FromEarliest: o.FromEarliest,
+		AutoCommit:   o.AutoCommit,
+	}, nil
+}
+
+// KafkaMessage is a consumed message
+type KafkaMessage struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   []KafkaHeader
+	Timestamp time.Time
+}
+
+// kafkaMessagesFromProto converts consumed messages
+func kafkaMessagesFromProto(protoMessages []*pb.KafkaMessage) []*KafkaMessage {
+	messages := make([]*KafkaMessage, len(protoMessages))
+	for i, m := range protoMessages {
+		msg := &KafkaMessage{
+			Topic:     m.Topic,
+			Partition: m.Partition,
+			Offset:    m.Offset,
+			Key:       m.Key,
+			Value:     m.Value,
+		}
+		if m.TimestampMs != 0 {
+			msg.Timestamp = time.UnixMilli(m.TimestampMs)
+		}
+		for _, h := range m.Headers {
+			msg.Headers = append(msg.Headers, KafkaHeader{Key: h.Key, Value: h.Value})
+		}
+		messages[i] = msg
+	}
+	return messages
+}
+
+// kafkaCommitFunc commits consumer group offsets
+type kafkaCommitFunc func(ctx context.Context, req *pb.KafkaCommitRequest) (*pb.KafkaCommitResponse, error)
+
+// KafkaSubscription delivers consumed messages until it is closed or the
+// context passed to SubscribeKafka is cancelled.
+// Call Next in a loop, or range over Messages.
+type KafkaSubscription struct {
+	stream  *pagedStream[*KafkaMessage]
+	name    string
+	groupID string
+	commit  kafkaCommitFunc
+}
+
+// newKafkaSubscription wraps a backend receive function; cancel must release
+// every resource held by the backend stream
+func newKafkaSubscription(ctx context.Context, cancel context.CancelFunc, name, groupID string, recv func() (*page[*KafkaMessage], error), commit kafkaCommitFunc) *KafkaSubscription {
+	return &KafkaSubscription{
+		stream: &pagedStream[*KafkaMessage]{
+			ctx: ctx, cancel: cancel, recv: recv, op: "subscribe kafka",
+		},
+		name:    name,
+		groupID: groupID,
+		commit:  commit,
+	}
+}
+
+// Next blocks until the next message arrives. It returns io.EOF if the
+// server ends the subscription.
+func (s *KafkaSubscription) Next() (*KafkaMessage, error) {
+	return s.stream.next()
+}
+
+// Messages iterates over incoming messages and closes the subscription when
+// the loop ends, including on early break
+func (s *KafkaSubscription) Messages() iter.Seq2[*KafkaMessage, error] {
+	return s.stream.all()
+}
+
+// Commit records msgs as processed for the consumer group, so the group
+// resumes after them. For each partition the highest offset is committed.
+func (s *KafkaSubscription) Commit(ctx context.Context, msgs ...*KafkaMessage) error {
+	if s.groupID == "" {
+		return fmt.Errorf("commit kafka failed: subscription has no consumer group")
+	}
+
+	type topicPartition struct {
+		topic     string
+		partition int32
+	}
+	next := make(map[topicPartition]int64)
+	for _, msg := range msgs {
+		tp := topicPartition{msg.Topic, msg.Partition}
+		if offset, ok := next[tp]; !ok || msg.Offset+1 > offset {
+			next[tp] = msg.Offset + 1
+		}
+	}
+	if len(next) == 0 {
+		return nil
+	}
+
+	req := &pb.KafkaCommitRequest{Name: s.name, GroupId: s.groupID}
+	for tp, offset := range next {
+		req.Offsets = append(req.Offsets, &pb.KafkaPartitionOffset{
+			Topic:     tp.topic,
+			Partition: tp.partition,
+			Offset:    offset,
+		})
+	}
+	sort.Slice(req.Offsets, func(i, j int) bool {
+		a, b := req.Offsets[i], req.Offsets[j]
+		if a.Topic != b.Topic {
+			return a.Topic < b.Topic
+		}
+		return a.Partition < b.Partition
+	})
+
+	resp, err := s.commit(ctx, req)
+	if err != nil {
+		return fmt.Errorf("commit kafka failed: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("commit kafka failed: %s", resp.Error)
+	}
+	return nil
+}
+
+// Close stops the subscription; Next then returns io.EOF
+func (s *KafkaSubscription) Close() error {
+	s.stream.close()
+	return nil
+}
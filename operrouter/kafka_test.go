@@ -0,0 +1,211 @@
+package operrouter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+func TestPublishKafka(t *testing.T) {
+	tests := []struct {
+		name           string
+		records        []KafkaRecord
+		opts           []PublishOption
+		failBatch      int // 1-based batch the broker rejects, 0 for none
+		wantBatches    []string
+		wantAcks       pb.KafkaAcks
+		wantOK         bool
+		wantDeliveries []KafkaDelivery
+	}{
+		{"no records", nil, nil, 0, nil, pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED, true, nil},
+		{
+			"one batch",
+			[]KafkaRecord{{Topic: "orders", Value: []byte("a")}, {Topic: "payments", Value: []byte("b")}, {Topic: "orders", Value: []byte("c")}},
+			nil, 0,
+			[]string{"abc"},
+			pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED, true,
+			[]KafkaDelivery{{Topic: "orders", Partition: 1, Offset: 100}, {Topic: "payments", Partition: 1, Offset: 101}, {Topic: "orders", Partition: 1, Offset: 102}},
+		},
+		{
+			"batches in order",
+			[]KafkaRecord{
+				{Topic: "orders", Value: []byte("a")}, {Topic: "orders", Value: []byte("b")},
+				{Topic: "payments", Value: []byte("c")}, {Topic: "orders", Value: []byte("d")},
+				{Topic: "payments", Value: []byte("e")},
+			},
+			[]PublishOption{WithPublishBatchSize(2)}, 0,
+			[]string{"ab", "cd", "e"},
+			pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED, true,
+			[]KafkaDelivery{
+				{Topic: "orders", Partition: 1, Offset: 100}, {Topic: "orders", Partition: 1, Offset: 101},
+				{Topic: "payments", Partition: 1, Offset: 102}, {Topic: "orders", Partition: 1, Offset: 103},
+				{Topic: "payments", Partition: 1, Offset: 104},
+			},
+		},
+		{
+			"size zero sends one batch",
+			[]KafkaRecord{{Topic: "orders", Value: []byte("a")}, {Topic: "orders", Value: []byte("b")}, {Topic: "orders", Value: []byte("c")}},
+			[]PublishOption{WithPublishBatchSize(0)}, 0,
+			[]string{"abc"},
+			pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED, true,
+			[]KafkaDelivery{{Topic: "orders", Partition: 1, Offset: 100}, {Topic: "orders", Partition: 1, Offset: 101}, {Topic: "orders", Partition: 1, Offset: 102}},
+		},
+		{
+			"acks all",
+			[]KafkaRecord{{Topic: "orders", Value: []byte("a")}},
+			[]PublishOption{WithAcks(KafkaAcksAll)}, 0,
+			[]string{"a"},
+			pb.KafkaAcks_KAFKA_ACKS_ALL, true,
+			[]KafkaDelivery{{Topic: "orders", Partition: 1, Offset: 100}},
+		},
+		{
+			"acks none",
+			[]KafkaRecord{{Topic: "orders", Value: []byte("a")}},
+			[]PublishOption{WithAcks(KafkaAcksNone)}, 0,
+			[]string{"a"},
+			pb.KafkaAcks_KAFKA_ACKS_NONE, true,
+			[]KafkaDelivery{{Topic: "orders", Partition: 1, Offset: 100}},
+		},
+		{
+			"stops at rejected batch",
+			[]KafkaRecord{
+				{Topic: "orders", Value: []byte("a")}, {Topic: "orders", Value: []byte("b")},
+				{Topic: "payments", Value: []byte("c")}, {Topic: "payments", Value: []byte("d")},
+				{Topic: "orders", Value: []byte("e")}, {Topic: "orders", Value: []byte("f")},
+			},
+			[]PublishOption{WithPublishBatchSize(2)}, 2,
+			[]string{"ab", "cd"},
+			pb.KafkaAcks_KAFKA_ACKS_UNSPECIFIED, false,
+			[]KafkaDelivery{
+				{Topic: "orders", Partition: 1, Offset: 100}, {Topic: "orders", Partition: 1, Offset: 101},
+				{Topic: "payments", Partition: 1, Offset: 102}, {Topic: "payments", Partition: 1, Offset: 103},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches []string
+			offset := int64(100)
+			publishBatch := func(ctx context.Context, req *pb.KafkaPublishRequest) (*pb.KafkaPublishResponse, error) {
+				if req.Name != "events" || req.Acks != tt.wantAcks {
+					t.Errorf("request name %q acks %v, want events and %v", req.Name, req.Acks, tt.wantAcks)
+				}
+				var values string
+				resp := &pb.KafkaPublishResponse{Success: len(batches)+1 != tt.failBatch}
+				for _, r := range req.Records {
+					values += string(r.Value)
+					resp.Reports = append(resp.Reports, &pb.KafkaDeliveryReport{Partition: 1, Offset: offset})
+					offset++
+				}
+				batches = append(batches, values)
+				return resp, nil
+			}
+
+			got, err := publishKafka(context.Background(), "events", tt.records, tt.opts, publishBatch)
+			if err != nil {
+				t.Fatalf("publishKafka: %v", err)
+			}
+			if !reflect.DeepEqual(batches, tt.wantBatches) {
+				t.Errorf("batches = %q, want %q", batches, tt.wantBatches)
+			}
+			if got.Success != tt.wantOK || !reflect.DeepEqual(got.Deliveries, tt.wantDeliveries) {
+				t.Errorf("publishKafka = %+v, want success %v with deliveries %+v", got, tt.wantOK, tt.wantDeliveries)
+			}
+		})
+	}
+}
+
+func TestPublishKafkaErrors(t *testing.T) {
+	calls := 0
+	publishBatch := func(ctx context.Context, req *pb.KafkaPublishRequest) (*pb.KafkaPublishResponse, error) {
+		calls++
+		if calls == 2 {
+			return nil, errors.New("broker down")
+		}
+		resp := &pb.KafkaPublishResponse{Success: true}
+		for range req.Records {
+			resp.Reports = append(resp.Reports, &pb.KafkaDeliveryReport{})
+		}
+		return resp, nil
+	}
+
+	records := []KafkaRecord{
+		{Topic: "orders", Value: []byte("a")}, {Topic: "orders", Value: []byte("b")},
+		{Topic: "orders", Value: []byte("c")}, {Topic: "orders", Value: []byte("d")},
+		{Topic: "orders", Value: []byte("e")},
+	}
+	_, err := publishKafka(context.Background(), "events", records, []PublishOption{WithPublishBatchSize(2)}, publishBatch)
+	if err == nil || err.Error() != "publish kafka failed after 2 records: broker down" {
+		t.Errorf("publishKafka error = %v, want it to report 2 records published", err)
+	}
+	if calls != 2 {
+		t.Errorf("publishBatch called %d times, want 2", calls)
+	}
+
+	_, err = publishKafka(context.Background(), "events", []KafkaRecord{{Topic: "orders", Value: []byte("a")}}, []PublishOption{WithAcks(KafkaAcks(9))}, publishBatch)
+	if err == nil || !strings.Contains(err.Error(), "unknown acks 9") {
+		t.Errorf("publishKafka error = %v, want unknown acks", err)
+	}
+}
+
+func TestHTTPPublishKafkaJSON(t *testing.T) {
+	var params map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string                 `json:"method"`
+			Params map[string]interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "kafka.publish" {
+			t.Errorf("request %+v, %v: want kafka.publish", req, err)
+		}
+		params = req.Params
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"success":true,"reports":[{"partition":2,"offset":7}]}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewHTTPWithOptions(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partition := int32(2)
+	resp, err := client.PublishKafka(context.Background(), "events", []KafkaRecord{{
+		Topic:     "orders",
+		Key:       []byte("k"),
+		Value:     []byte{0, 1, 2},
+		Headers:   []KafkaHeader{{Key: "trace", Value: []byte("abc")}},
+		Partition: &partition,
+		Timestamp: time.UnixMilli(1700000000123),
+	}}, WithAcks(KafkaAcksLeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"name": "events",
+		"acks": "leader",
+		"records": []interface{}{map[string]interface{}{
+			"topic":        "orders",
+			"key":          "aw==",
+			"value":        "AAEC",
+			"headers":      []interface{}{map[string]interface{}{"key": "trace", "value": "YWJj"}},
+			"partition":    float64(2),
+			"timestamp_ms": float64(1700000000123),
+		}},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params = %#v, want %#v", params, want)
+	}
+	wantDeliveries := []KafkaDelivery{{Topic: "orders", Partition: 2, Offset: 7}}
+	if !resp.Success || !reflect.DeepEqual(resp.Deliveries, wantDeliveries) {
+		t.Errorf("PublishKafka = %+v, want deliveries %+v", resp, wantDeliveries)
+	}
+}
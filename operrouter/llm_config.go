@@ -0,0 +1,161 @@
+package operrouter
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// LLMConfig describes an LLM client created with CreateLLM.
+// Optional numeric settings are pointers so that zero values can be sent.
+type LLMConfig struct {
+	// Provider is one of "openai", "anthropic" (or "claude"), "ollama" or "local"
+	Provider string
+	Model    string
+	APIKey   string
+	// APIBase overrides the provider endpoint, e.g. for Ollama or an
+	// OpenAI-compatible server
+	APIBase        string
+	Temperature    *float32
+	MaxTokens      *uint32
+	TimeoutSeconds *uint64
+	// Extra carries provider settings the SDK does not model, such as
+	// "organization" or "api_version"
+	Extra map[string]string
+}
+
+// llmConfigKeys are the map keys understood by ParseLLMConfig
+var llmConfigKeys = []string{
+	"provider", "model", "api_key", "api_base",
+	"temperature", "max_tokens", "timeout_seconds",
+}
+
+// ParseLLMConfig builds an LLMConfig from the map form accepted by CreateLLM.
+// Numbers may be any Go integer or float type or a json.Number. Keys the SDK
+// does not know are kept in Extra.
+func ParseLLMConfig(config map[string]interface{}) (*LLMConfig, error) {
+	cfg := &LLMConfig{}
+	for _, k := range unknownKeys(config, llmConfigKeys...) {
+		if config[k] == nil {
+			continue
+		}
+		if cfg.Extra == nil {
+			cfg.Extra = make(map[string]string)
+		}
+		cfg.Extra[k] = fmt.Sprintf("%v", config[k])
+	}
+	for _, field := range []struct {
+		key string
+		dst *string
+	}{
+		{"provider", &cfg.Provider},
+		{"model", &cfg.Model},
+		{"api_key", &cfg.APIKey},
+		{"api_base", &cfg.APIBase},
+	} {
+		v, ok := config[field.key]
+		if !ok || v == nil {
+			continue
+		}
+		str, err := toString(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid llm config: %s: %w", field.key, err)
+		}
+		*field.dst = str
+	}
+
+	if v, ok := config["temperature"]; ok && v != nil {
+		f, err := toFloat64(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid llm config: temperature: %w", err)
+		}
+		t := float32(f)
+		cfg.Temperature = &t
+	}
+	if v, ok := config["max_tokens"]; ok && v != nil {
+		n, err := toUint32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid llm config: max_tokens: %w", err)
+		}
+		cfg.MaxTokens = &n
+	}
+	if v, ok := config["timeout_seconds"]; ok && v != nil {
+		n, err := toUint64(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid llm config: timeout_seconds: %w", err)
+		}
+		cfg.TimeoutSeconds = &n
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks the provider and the ranges of numeric settings
+func (c *LLMConfig) Validate() error {
+	if c.Provider == "" {
+		return fmt.Errorf("invalid llm config: provider is required")
+	}
+	if mapLLMProvider(c.Provider) == pb.LLMProvider_LLM_PROVIDER_UNSPECIFIED {
+		return fmt.Errorf("invalid llm config: unknown provider %q", c.Provider)
+	}
+	if c.Temperature != nil {
+		t := *c.Temperature
+		if math.IsNaN(float64(t)) || t < 0 || t > 2 {
+			return fmt.Errorf("invalid llm config: temperature must be between 0 and 2, got %v", t)
+		}
+	}
+	return nil
+}
+
+// Map returns the config in the map form accepted by CreateLLM
+func (c *LLMConfig) Map() map[string]interface{} {
+	m := make(map[string]interface{}, len(c.Extra)+1)
+	for k, v := range c.Extra {
+		m[k] = v
+	}
+	m["provider"] = c.Provider
+	if c.Model != "" {
+		m["model"] = c.Model
+	}
+	if c.APIKey != "" {
+		m["api_key"] = c.APIKey
+	}
+	if c.APIBase != "" {
+		m["api_base"] = c.APIBase
+	}
+	if c.Temperature != nil {
+		m["temperature"] = *c.Temperature
+	}
+	if c.MaxTokens != nil {
+		m["max_tokens"] = *c.MaxTokens
+	}
+	if c.TimeoutSeconds != nil {
+		m["timeout_seconds"] = *c.TimeoutSeconds
+	}
+	return m
+}
+
+// toProto fills every field of pb.LLMConfig
+func (c *LLMConfig) toProto() *pb.LLMConfig {
+	configProto := &pb.LLMConfig{
+		Provider:       mapLLMProvider(c.Provider),
+		Model:          c.Model,
+		Temperature:    c.Temperature,
+		MaxTokens:      c.MaxTokens,
+		TimeoutSeconds: c.TimeoutSeconds,
+		Extra:          c.Extra,
+	}
+	if c.APIKey != "" {
+		apiKey := c.APIKey
+		configProto.ApiKey = &apiKey
+	}
+	if c.APIBase != "" {
+		apiBase := c.APIBase
+		configProto.ApiBase = &apiBase
+	}
+	return configProto
+}
@@ -0,0 +1,288 @@
+package operrouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// LLMOption configures a single LLM call. Generation settings override the
+// ones given to CreateLLM for that call only.
+type LLMOption func(*llmOptions)
+
+// llmOptions holds the settings collected from LLMOption values
+type llmOptions struct {
+	tools      []Tool
+	toolChoice string
+
+	temperature      *float32
+	topP             *float32
+	maxTokens        *uint32
+	stop             []string
+	seed             *int64
+	presencePenalty  *float32
+	frequencyPenalty *float32
+	responseFormat   *ResponseFormat
+
+	// maxAttempts is only read by GenerateStructured
+	maxAttempts *int
+}
+
+// ResponseFormatType selects the shape of the generated text
+type ResponseFormatType string
+
+const (
+	ResponseFormatText ResponseFormatType = "text"
+	// ResponseFormatJSON asks for any JSON object
+	ResponseFormatJSON ResponseFormatType = "json_object"
+	// ResponseFormatJSONSchema asks for JSON matching ResponseFormat.Schema
+	ResponseFormatJSONSchema ResponseFormatType = "json_schema"
+)
+
+// ResponseFormat constrains the generated text, e.g. to JSON
+type ResponseFormat struct {
+	Type ResponseFormatType
+	// Name and Schema are used with ResponseFormatJSONSchema
+	Name   string
+	Schema json.RawMessage
+	// Strict asks the provider to enforce the schema exactly, where supported
+	Strict bool
+}
+
+// WithTools declares tools the model may call. Calls come back in
+// LLMGenerateResponse.ToolCalls. Only ChatLLM accepts tools.
+func WithTools(tools ...Tool) LLMOption {
+	return func(o *llmOptions) {
+		o.tools = append(o.tools, tools...)
+	}
+}
+
+// WithToolChoice controls tool use: ToolChoiceAuto (the default),
+// ToolChoiceNone, ToolChoiceRequired, or the name of the tool to call
+func WithToolChoice(choice string) LLMOption {
+	return func(o *llmOptions) {
+		o.toolChoice = choice
+	}
+}
+
+// WithTemperature sets the sampling temperature, between 0 and 2
+func WithTemperature(temperature float32) LLMOption {
+	return func(o *llmOptions) {
+		o.temperature = &temperature
+	}
+}
+
+// WithTopP sets nucleus sampling, between 0 and 1
+func WithTopP(topP float32) LLMOption {
+	return func(o *llmOptions) {
+		o.topP = &topP
+	}
+}
+
+// WithMaxTokens caps the number of generated tokens
+func WithMaxTokens(maxTokens uint32) LLMOption {
+	return func(o *llmOptions) {
+		o.maxTokens = &maxTokens
+	}
+}
+
+// WithStop ends generation at any of the given sequences
+func WithStop(sequences ...string) LLMOption {
+	return func(o *llmOptions) {
+		o.stop = append(o.stop, sequences...)
+	}
+}
+
+// WithSeed requests deterministic sampling, where the provider supports it
+func WithSeed(seed int64) LLMOption {
+	return func(o *llmOptions) {
+		o.seed = &seed
+	}
+}
+
+// WithPresencePenalty penalizes tokens that already appeared, between -2 and 2
+func WithPresencePenalty(penalty float32) LLMOption {
+	return func(o *llmOptions) {
+		o.presencePenalty = &penalty
+	}
+}
+
+// WithFrequencyPenalty penalizes tokens by how often they appeared, between -2 and 2
+func WithFrequencyPenalty(penalty float32) LLMOption {
+	return func(o *llmOptions) {
+		o.frequencyPenalty = &penalty
+	}
+}
+
+// WithResponseFormat constrains the generated text
+func WithResponseFormat(format ResponseFormat) LLMOption {
+	return func(o *llmOptions) {
+		o.responseFormat = &format
+	}
+}
+
+// WithJSONMode asks for a JSON object
+func WithJSONMode() LLMOption {
+	return WithResponseFormat(ResponseFormat{Type: ResponseFormatJSON})
+}
+
+// WithJSONSchema asks for JSON matching schema, enforced strictly where the
+// provider supports it
+func WithJSONSchema(name string, schema json.RawMessage) LLMOption {
+	return WithResponseFormat(ResponseFormat{Type: ResponseFormatJSONSchema, Name: name, Schema: schema, Strict: true})
+}
+
+// newLLMOptions applies opts
+func newLLMOptions(opts []LLMOption) *llmOptions {
+	o := &llmOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// generation validates the generation settings and converts them; it
+// returns nil when none is set
+func (o *llmOptions) generation() (*pb.GenerationOptions, error) {
+	if o.maxAttempts != nil {
+		return nil, fmt.Errorf("max attempts is only supported by GenerateStructured")
+	}
+	for _, r := range []struct {
+		name     string
+		value    *float32
+		min, max float32
+	}{
+		{"temperature", o.temperature, 0, 2},
+		{"top_p", o.topP, 0, 1},
+		{"presence_penalty", o.presencePenalty, -2, 2},
+		{"frequency_penalty", o.frequencyPenalty, -2, 2},
+	} {
+		if r.value == nil {
+			continue
+		}
+		if v := *r.value; math.IsNaN(float64(v)) || v < r.min || v > r.max {
+			return nil, fmt.Errorf("%s must be between %v and %v, got %v", r.name, r.min, r.max, v)
+		}
+	}
+	if o.maxTokens != nil && *o.maxTokens == 0 {
+		return nil, fmt.Errorf("max_tokens must be positive")
+	}
+
+	g := &pb.GenerationOptions{
+		Temperature:      o.temperature,
+		TopP:             o.topP,
+		MaxTokens:        o.maxTokens,
+		Stop:             o.stop,
+		Seed:             o.seed,
+		PresencePenalty:  o.presencePenalty,
+		FrequencyPenalty: o.frequencyPenalty,
+	}
+	if o.responseFormat != nil {
+		format, err := o.responseFormat.toProto()
+		if err != nil {
+			return nil, err
+		}
+		g.ResponseFormat = format
+	}
+
+	if g.Temperature == nil && g.TopP == nil && g.MaxTokens == nil && len(g.Stop) == 0 &&
+		g.Seed == nil && g.PresencePenalty == nil && g.FrequencyPenalty == nil && g.ResponseFormat == nil {
+		return nil, nil
+	}
+	return g, nil
+}
+
+// toProto validates and converts the response format
+func (f *ResponseFormat) toProto() (*pb.ResponseFormat, error) {
+	format := &pb.ResponseFormat{SchemaName: f.Name, Strict: f.Strict}
+	switch f.Type {
+	case ResponseFormatText:
+		format.Type = pb.ResponseFormatType_RESPONSE_FORMAT_TYPE_TEXT
+	case ResponseFormatJSON:
+		format.Type = pb.ResponseFormatType_RESPONSE_FORMAT_TYPE_JSON_OBJECT
+	case ResponseFormatJSONSchema:
+		if len(f.Schema) == 0 || !json.Valid(f.Schema) {
+			return nil, fmt.Errorf("response format %s needs a valid JSON schema", f.Type)
+		}
+		format.Type = pb.ResponseFormatType_RESPONSE_FORMAT_TYPE_JSON_SCHEMA
+		format.SchemaJson = string(f.Schema)
+	default:
+		return nil, fmt.Errorf("unknown response format %q", f.Type)
+	}
+	return format, nil
+}
+
+// generateRequest builds a GenerateLLMRequest; tools are rejected because
+// only ChatLLM can return tool calls
+func generateRequest(name, prompt string, opts []LLMOption) (*pb.GenerateLLMRequest, error) {
+	o := newLLMOptions(opts)
+	if len(o.tools) > 0 || o.toolChoice != "" {
+		return nil, fmt.Errorf("generate llm failed: tools are only supported by ChatLLM")
+	}
+	options, err := o.generation()
+	if err != nil {
+		return nil, fmt.Errorf("generate llm failed: %w", err)
+	}
+	return &pb.GenerateLLMRequest{Name: name, Prompt: prompt, Options: options}, nil
+}
+
+// streamRequest builds a StreamLLMRequest; like GenerateLLM, it takes no tools
+func streamRequest(name, prompt string, opts []LLMOption) (*pb.StreamLLMRequest, error) {
+	o := newLLMOptions(opts)
+	if len(o.tools) > 0 || o.toolChoice != "" {
+		return nil, fmt.Errorf("stream llm failed: tools are only supported by ChatLLM")
+	}
+	options, err := o.generation()
+	if err != nil {
+		return nil, fmt.Errorf("stream llm failed: %w", err)
+	}
+	return &pb.StreamLLMRequest{Name: name, Prompt: prompt, Options: options}, nil
+}
+
+// addGenerationParams adds the generation settings to JSON-RPC params as an
+// "options" object mirroring GenerationOptions
+func addGenerationParams(params map[string]interface{}, g *pb.GenerationOptions) {
+	if g == nil {
+		return
+	}
+	options := make(map[string]interface{})
+	if g.Temperature != nil {
+		options["temperature"] = *g.Temperature
+	}
+	if g.TopP != nil {
+		options["top_p"] = *g.TopP
+	}
+	if g.MaxTokens != nil {
+		options["max_tokens"] = *g.MaxTokens
+	}
+	if len(g.Stop) > 0 {
+		options["stop"] = g.Stop
+	}
+	if g.Seed != nil {
+		options["seed"] = *g.Seed
+	}
+	if g.PresencePenalty != nil {
+		options["presence_penalty"] = *g.PresencePenalty
+	}
+	if g.FrequencyPenalty != nil {
+		options["frequency_penalty"] = *g.FrequencyPenalty
+	}
+	if f := g.ResponseFormat; f != nil {
+		// "text", "json_object" or "json_schema"
+		format := map[string]interface{}{
+			"type": strings.ToLower(strings.TrimPrefix(f.Type.String(), "RESPONSE_FORMAT_TYPE_")),
+		}
+		if f.SchemaJson != "" {
+			format["name"] = f.SchemaName
+			format["schema"] = json.RawMessage(f.SchemaJson)
+			format["strict"] = f.Strict
+		}
+		options["response_format"] = format
+	}
+	params["options"] = options
+}
@@ -0,0 +1,124 @@
+package operrouter
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerationParams(t *testing.T) {
+	schema := json.RawMessage(`{"type":"object"}`)
+
+	tests := []struct {
+		name string
+		opts []LLMOption
+		want map[string]interface{} // nil when no "options" are sent
+	}{
+		{"no options", nil, nil},
+		{"nil option", []LLMOption{nil}, nil},
+		{
+			"sampling",
+			[]LLMOption{WithTemperature(0), WithTopP(0.5), WithMaxTokens(64), WithSeed(-1)},
+			map[string]interface{}{"temperature": float32(0), "top_p": float32(0.5), "max_tokens": uint32(64), "seed": int64(-1)},
+		},
+		{
+			"later options win and stops add up",
+			[]LLMOption{WithTemperature(2), WithStop("\n"), WithTemperature(1), WithStop("END", "STOP")},
+			map[string]interface{}{"temperature": float32(1), "stop": []string{"\n", "END", "STOP"}},
+		},
+		{
+			"penalties",
+			[]LLMOption{WithPresencePenalty(-2), WithFrequencyPenalty(2)},
+			map[string]interface{}{"presence_penalty": float32(-2), "frequency_penalty": float32(2)},
+		},
+		{
+			"json mode",
+			[]LLMOption{WithJSONMode()},
+			map[string]interface{}{"response_format": map[string]interface{}{"type": "json_object"}},
+		},
+		{
+			"json schema",
+			[]LLMOption{WithJSONSchema("person", schema)},
+			map[string]interface{}{"response_format": map[string]interface{}{
+				"type": "json_schema", "name": "person", "schema": schema, "strict": true,
+			}},
+		},
+		{
+			"text format",
+			[]LLMOption{WithResponseFormat(ResponseFormat{Type: ResponseFormatText})},
+			map[string]interface{}{"response_format": map[string]interface{}{"type": "text"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := generateRequest("gpt", "hi", tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (req.Options == nil) != (tt.want == nil) {
+				t.Errorf("Options = %v, want set %v", req.Options, tt.want != nil)
+			}
+			params := map[string]interface{}{}
+			addGenerationParams(params, req.Options)
+			if got, ok := params["options"]; ok != (tt.want != nil) || (ok && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("options = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerationErrors(t *testing.T) {
+	build := map[string]func([]LLMOption) error{
+		"generate llm": func(opts []LLMOption) error {
+			_, err := generateRequest("gpt", "hi", opts)
+			return err
+		},
+		"stream llm": func(opts []LLMOption) error {
+			_, err := streamRequest("gpt", "hi", opts)
+			return err
+		},
+		"chat llm": func(opts []LLMOption) error {
+			_, err := chatRequest("gpt", []ChatMessage{UserMessage("hi")}, opts)
+			return err
+		},
+	}
+
+	tests := []struct {
+		name string
+		opt  LLMOption
+		err  string
+	}{
+		{"temperature too high", WithTemperature(2.5), "temperature must be between 0 and 2, got 2.5"},
+		{"negative temperature", WithTemperature(-0.1), "temperature must be between 0 and 2"},
+		{"NaN temperature", WithTemperature(float32(math.NaN())), "temperature must be between 0 and 2, got NaN"},
+		{"top_p above 1", WithTopP(1.5), "top_p must be between 0 and 1"},
+		{"presence penalty", WithPresencePenalty(-3), "presence_penalty must be between -2 and 2"},
+		{"frequency penalty", WithFrequencyPenalty(2.1), "frequency_penalty must be between -2 and 2"},
+		{"zero max tokens", WithMaxTokens(0), "max_tokens must be positive"},
+		{
+			"schema missing",
+			WithResponseFormat(ResponseFormat{Type: ResponseFormatJSONSchema, Name: "p"}),
+			"response format json_schema needs a valid JSON schema",
+		},
+		{
+			"schema invalid",
+			WithJSONSchema("p", json.RawMessage(`{"type":`)),
+			"response format json_schema needs a valid JSON schema",
+		},
+		{"unknown format", WithResponseFormat(ResponseFormat{Type: "yaml"}), `unknown response format "yaml"`},
+		{"max attempts", WithMaxAttempts(2), "max attempts is only supported by GenerateStructured"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for call, f := range build {
+				if err := f([]LLMOption{tt.opt}); err == nil || !strings.Contains(err.Error(), call+" failed: "+tt.err) {
+					t.Errorf("%s: error = %v, want %q", call, err, tt.err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package operrouter
+
+import (
+	"fmt"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// MongoSort orders find results by one field
+type MongoSort struct {
+	Field      string
+	Descending bool
+}
+
+// MongoFindOptions shapes the results of FindMongo; every field is optional
+type MongoFindOptions struct {
+	// Projection selects the fields returned, e.g. {"name": 1, "_id": 0}
+	Projection map[string]interface{}
+	// Sort orders the results by each field in turn
+	Sort []MongoSort
+	// Limit caps the number of documents; 0 returns every match
+	Limit int64
+	// Skip drops that many matches before the first document returned
+	Skip int64
+}
+
+// MongoUpdateOptions configures UpdateMongo
+type MongoUpdateOptions struct {
+	// Many updates every match instead of the first
+	Many bool
+	// Upsert inserts a document built from filter and update when nothing matches
+	Upsert bool
+}
+
+// MongoFindResponse holds the matched documents as nested Go maps, with
+// values converted by ValueToGo
+type MongoFindResponse struct {
+	Success   bool
+	Documents []map[string]interface{}
+	Message   string
+}
+
+// MongoInsertResponse holds the _id of each inserted document, in order
+type MongoInsertResponse struct {
+	Success     bool
+	InsertedIDs []interface{}
+	Message     string
+}
+
+// MongoUpdateResponse reports the outcome of UpdateMongo
+type MongoUpdateResponse struct {
+	Success       bool
+	MatchedCount  uint64
+	ModifiedCount uint64
+	// UpsertedID is the _id of the inserted document, or nil if none was
+	UpsertedID interface{}
+	Message    string
+}
+
+// MongoDeleteResponse reports the outcome of DeleteMongo
+type MongoDeleteResponse struct {
+	Success      bool
+	DeletedCount uint64
+	Message      string
+}
+
+// mongoDocument converts a document using GoToValue; a nil document stays nil
+// so the server applies its default, e.g. no projection for find
+func mongoDocument(doc map[string]interface{}) (*pb.ValueObject, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	fields := make(map[string]*pb.Value, len(doc))
+	for key, val := range doc {
+		value, err := GoToValue(val)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", key, err)
+		}
+		fields[key] = value
+	}
+	return &pb.ValueObject{Fields: fields}, nil
+}
+
+// mongoFindRequest builds a MongoFindRequest; opts may be nil
+func mongoFindRequest(name, collection string, filter map[string]interface{}, opts *MongoFindOptions) (*pb.MongoFindRequest, error) {
+	if opts == nil {
+		opts = &MongoFindOptions{}
+	}
+	if opts.Limit < 0 || opts.Skip < 0 {
+		return nil, fmt.Errorf("find mongo failed: limit and skip must not be negative")
+	}
+
+	req := &pb.MongoFindRequest{Name: name, Collection: collection}
+	var err error
+	if req.Filter, err = mongoDocument(filter); err != nil {
+		return nil, fmt.Errorf("find mongo failed: filter: %w", err)
+	}
+	if req.Projection, err = mongoDocument(opts.Projection); err != nil {
+		return nil, fmt.Errorf("find mongo failed: projection: %w", err)
+	}
+	for _, s := range opts.Sort {
+		req.Sort = append(req.Sort, &pb.SortField{Field: s.Field, Descending: s.Descending})
+	}
+	if opts.Limit > 0 {
+		req.Limit = &opts.Limit
+	}
+	if opts.Skip > 0 {
+		req.Skip = &opts.Skip
+	}
+	return req, nil
+}
+
+// mongoInsertRequest builds a MongoInsertRequest
+func mongoInsertRequest(name, collection string, documents []map[string]interface{}) (*pb.MongoInsertRequest, error) {
+	req := &pb.MongoInsertRequest{
+		Name:       name,
+		Collection: collection,
+		Documents:  make([]*pb.ValueObject, len(documents)),
+	}
+	for i, doc := range documents {
+		if doc == nil {
+			doc = map[string]interface{}{}
+		}
+		object, err := mongoDocument(doc)
+		if err != nil {
+			return nil, fmt.Errorf("insert mongo failed: document %d: %w", i, err)
+		}
+		req.Documents[i] = object
+	}
+	return req, nil
+}
+
+// mongoUpdateRequest builds a MongoUpdateRequest; opts may be nil
+func mongoUpdateRequest(name, collection string, filter, update map[string]interface{}, opts *MongoUpdateOptions) (*pb.MongoUpdateRequest, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("update mongo failed: filter is required; pass an empty map to match every document")
+	}
+	if len(update) == 0 {
+		return nil, fmt.Errorf("update mongo failed: update document is empty")
+	}
+	if opts == nil {
+		opts = &MongoUpdateOptions{}
+	}
+
+	req := &pb.MongoUpdateRequest{
+		Name:       name,
+		Collection: collection,
+		Many:       opts.Many,
+		Upsert:     opts.Upsert,
+	}
+	var err error
+	if req.Filter, err = mongoDocument(filter); err != nil {
+		return nil, fmt.Errorf("update mongo failed: filter: %w", err)
+	}
+	if req.Update, err = mongoDocument(update); err != nil {
+		return nil, fmt.Errorf("update mongo failed: update: %w", err)
+	}
+	return req, nil
+}
+
+// mongoDeleteRequest builds a MongoDeleteRequest
+func mongoDeleteRequest(name, collection string, filter map[string]interface{}, many bool) (*pb.MongoDeleteRequest, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("delete mongo failed: filter is required; pass an empty map to match every document")
+	}
+	object, err := mongoDocument(filter)
+	if err != nil {
+		return nil, fmt.Errorf("delete mongo failed: filter: %w", err)
+	}
+	return &pb.MongoDeleteRequest{
+		Name:       name,
+		Collection: collection,
+		Filter:     object,
+		Many:       many,
+	}, nil
+}
+
+// mongoFindResponse converts a MongoFindResponse
+func mongoFindResponse(resp *pb.MongoFindResponse) *MongoFindResponse {
+	documents := make([]map[string]interface{}, len(resp.Documents))
+	for i, doc := range resp.Documents {
+		documents[i] = objectToGo(doc)
+	}
+	return &MongoFindResponse{
+		Success:   resp.Success,
+		Documents: documents,
+		Message:   resp.Error,
+	}
+}
+
+// mongoInsertResponse converts a MongoInsertResponse
+func mongoInsertResponse(resp *pb.MongoInsertResponse) *MongoInsertResponse {
+	ids := make([]interface{}, len(resp.InsertedIds))
+	for i, id := range resp.InsertedIds {
+		ids[i] = ValueToGo(id)
+	}
+	return &MongoInsertResponse{
+		Success:     resp.Success,
+		InsertedIDs: ids,
+		Message:     resp.Error,
+	}
+}
+
+// mongoUpdateResponse converts a MongoUpdateResponse
+func mongoUpdateResponse(resp *pb.MongoUpdateResponse) *MongoUpdateResponse {
+	result := &MongoUpdateResponse{
+		Success:       resp.Success,
+		MatchedCount:  resp.MatchedCount,
+		ModifiedCount: resp.ModifiedCount,
+		Message:       resp.Error,
+	}
+	if resp.UpsertedId != nil {
+		result.UpsertedID = ValueToGo(resp.UpsertedId)
+	}
+	return result
+}
+
+// mongoDocumentParam converts a request document back to plain Go values for
+// JSON-RPC params, keeping nil documents out of the request
+func mongoDocumentParam(params map[string]interface{}, key string, doc *pb.ValueObject) {
+	if doc != nil {
+		params[key] = objectToGo(doc)
+	}
+}
@@ -0,0 +1,56 @@
+package operrouter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestHTTPFindMongoJSON(t *testing.T) {
+	var params map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string                 `json:"method"`
+			Params map[string]interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "mongo.find" {
+			t.Errorf("request %+v, %v: want mongo.find", req, err)
+		}
+		params = req.Params
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"success":true,"documents":[{"name":"a","age":3}]}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewHTTPWithOptions(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.FindMongo(context.Background(), "docs", "users", map[string]interface{}{"age": map[string]interface{}{"$gt": 2}}, &MongoFindOptions{
+		Sort:  []MongoSort{{Field: "age", Descending: true}, {Field: "name"}},
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"name":       "docs",
+		"collection": "users",
+		"filter":     map[string]interface{}{"age": map[string]interface{}{"$gt": float64(2)}},
+		"sort": []interface{}{
+			map[string]interface{}{"field": "age", "descending": true},
+			map[string]interface{}{"field": "name", "descending": false},
+		},
+		"limit": float64(10),
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params = %#v, want %#v", params, want)
+	}
+	wantDocs := []map[string]interface{}{{"name": "a", "age": int64(3)}}
+	if !resp.Success || !reflect.DeepEqual(resp.Documents, wantDocs) {
+		t.Errorf("FindMongo = %+v, want documents %v", resp, wantDocs)
+	}
+}
@@ -0,0 +1,281 @@
+package operrouter
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strings"
+	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ClientOption configures a client
+type ClientOption func(*clientOptions)
+
+// clientOptions holds the settings collected from ClientOption values
+type clientOptions struct {
+	timeout      time.Duration
+	endpointPath string
+	headers      map[string]string
+	userAgent    string
+	logger       *slog.Logger
+	retry        *RetryPolicy
+	tlsConfig    *tls.Config
+
+	// applied records the name of every option passed, in order
+	applied []string
+}
+
+// RetryPolicy controls how failed calls are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each retry
+	Multiplier float64
+}
+
+// DefaultRetryPolicy is a conservative policy suitable for most deployments
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+}
+
+// WithTimeout sets the default per-call timeout, applied to calls whose
+// context carries no deadline
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithTimeout")
+		o.timeout = timeout
+	}
+}
+
+// WithEndpointPath sets the HTTP path of the JSON-RPC endpoint (default "/jsonrpc")
+func WithEndpointPath(path string) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithEndpointPath")
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		o.endpointPath = path
+	}
+}
+
+// WithHeader adds a header to every HTTP request, or metadata to every gRPC call
+func WithHeader(key, value string) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithHeader")
+		if o.headers == nil {
+			o.headers = make(map[string]string)
+		}
+		o.headers[key] = value
+	}
+}
+
+// WithHeaders adds several headers (HTTP) or metadata entries (gRPC) at once
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithHeaders")
+		if o.headers == nil {
+			o.headers = make(map[string]string, len(headers))
+		}
+		for k, v := range headers {
+			o.headers[k] = v
+		}
+	}
+}
+
+// WithUserAgent sets the user agent reported to the server
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithUserAgent")
+		o.userAgent = userAgent
+	}
+}
+
+// WithLogger sets the logger used to report failed calls and retries
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithLogger")
+		o.logger = logger
+	}
+}
+
+// WithRetryPolicy retries calls that fail with transient transport errors.
+// Only calls that are safe to repeat, such as queries, pings and LLM
+// generation, are retried once the request may have reached the server;
+// writes, commits and publishes are retried only when the connection could
+// not be established at all.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithRetryPolicy")
+		o.retry = &policy
+	}
+}
+
+// WithTLSConfig enables TLS with the given configuration
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(o *clientOptions) {
+		o.applied = append(o.applied, "WithTLSConfig")
+		o.tlsConfig = config
+	}
+}
+
+// newClientOptions applies opts and rejects any option the backend does not support
+func newClientOptions(backend string, supported []string, opts []ClientOption) (*clientOptions, error) {
+	o := &clientOptions{
+		logger: slog.New(slog.DiscardHandler),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+
+	for _, name := range o.applied {
+		ok := false
+		for _, s := range supported {
+			if s == name {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("operrouter: option %s is not supported by the %s backend", name, backend)
+		}
+	}
+
+	if o.timeout < 0 {
+		return nil, fmt.Errorf("operrouter: timeout must not be negative, got %s", o.timeout)
+	}
+	if o.logger == nil {
+		o.logger = slog.New(slog.DiscardHandler)
+	}
+	if o.retry != nil {
+		if err := o.retry.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// idempotentHTTPMethods lists the JSON-RPC methods that may be sent again
+// after the server could have received them
+var idempotentHTTPMethods = map[string]bool{
+	"ping":                      true,
+	"validate_config":           true,
+	"load_config":               true,
+	"get_metadata":              true,
+	"datasource.query":          true,
+	"datasource.ping":           true,
+	"datasource.list_tables":    true,
+	"datasource.describe_table": true,
+	"mongo.find":                true,
+	"llm.generate":              true,
+	"llm.chat":                  true,
+	"llm.embedding":             true,
+	"llm.batch_embedding":       true,
+	"llm.ping":                  true,
+}
+
+// idempotentGRPCMethods lists the gRPC methods that may be sent again after
+// the server could have received them
+var idempotentGRPCMethods = map[string]bool{
+	pb.OperRouter_Ping_FullMethodName:              true,
+	pb.OperRouter_ValidateConfig_FullMethodName:    true,
+	pb.OperRouter_LoadConfig_FullMethodName:        true,
+	pb.OperRouter_GetMetadata_FullMethodName:       true,
+	pb.OperRouter_QueryDataSource_FullMethodName:   true,
+	pb.OperRouter_PingDataSource_FullMethodName:    true,
+	pb.OperRouter_ListTables_FullMethodName:        true,
+	pb.OperRouter_DescribeTable_FullMethodName:     true,
+	pb.OperRouter_MongoFind_FullMethodName:         true,
+	pb.OperRouter_GenerateLLM_FullMethodName:       true,
+	pb.OperRouter_ChatLLM_FullMethodName:           true,
+	pb.OperRouter_EmbeddingLLM_FullMethodName:      true,
+	pb.OperRouter_BatchEmbeddingLLM_FullMethodName: true,
+	pb.OperRouter_PingLLM_FullMethodName:           true,
+}
+
+// idempotentFFIRequests lists the FFI requests whose library call may be
+// abandoned when ctx ends; callFFI waits for the result of every other call
+// so that callers never mistake a write that went through for a cancelled one
+var idempotentFFIRequests = map[protoreflect.FullName]bool{
+	proto.MessageName(&pb.PingRequest{}):              true,
+	proto.MessageName(&pb.ValidateConfigRequest{}):    true,
+	proto.MessageName(&pb.LoadConfigRequest{}):        true,
+	proto.MessageName(&pb.GetMetadataRequest{}):       true,
+	proto.MessageName(&pb.QueryDataSourceRequest{}):   true,
+	proto.MessageName(&pb.PingDataSourceRequest{}):    true,
+	proto.MessageName(&pb.ListTablesRequest{}):        true,
+	proto.MessageName(&pb.DescribeTableRequest{}):     true,
+	proto.MessageName(&pb.MongoFindRequest{}):         true,
+	proto.MessageName(&pb.GenerateLLMRequest{}):       true,
+	proto.MessageName(&pb.ChatLLMRequest{}):           true,
+	proto.MessageName(&pb.EmbeddingLLMRequest{}):      true,
+	proto.MessageName(&pb.BatchEmbeddingLLMRequest{}): true,
+	proto.MessageName(&pb.PingLLMRequest{}):           true,
+}
+
+// withTimeout returns ctx bounded by the default timeout when it has no deadline
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("operrouter: retry policy needs at least 1 attempt, got %d", p.MaxAttempts)
+	}
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("operrouter: retry backoff must not be negative")
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = 1
+	}
+	return nil
+}
+
+// backoff returns the delay before the given retry (1-based), with jitter
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := float64(p.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		d *= p.Multiplier
+	}
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	// Jitter between half and the whole delay
+	return time.Duration(d/2 + rand.Float64()*d/2)
+}
+
+// sleep waits for the given retry's backoff or until ctx is done
+func (p *RetryPolicy) sleep(ctx context.Context, retry int) error {
+	timer := time.NewTimer(p.backoff(retry))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,266 @@
+package operrouter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIdempotentMethods(t *testing.T) {
+	tests := []struct {
+		http       string
+		grpc       string
+		ffi        proto.Message
+		idempotent bool
+	}{
+		{"datasource.query", pb.OperRouter_QueryDataSource_FullMethodName, &pb.QueryDataSourceRequest{}, true},
+		{"datasource.list_tables", pb.OperRouter_ListTables_FullMethodName, &pb.ListTablesRequest{}, true},
+		{"mongo.find", pb.OperRouter_MongoFind_FullMethodName, &pb.MongoFindRequest{}, true},
+		{"llm.chat", pb.OperRouter_ChatLLM_FullMethodName, &pb.ChatLLMRequest{}, true},
+		{"datasource.execute", pb.OperRouter_ExecuteDataSource_FullMethodName, &pb.ExecuteDataSourceRequest{}, false},
+		{"datasource.insert", pb.OperRouter_InsertDataSource_FullMethodName, &pb.InsertDataSourceRequest{}, false},
+		{"datasource.create", pb.OperRouter_CreateDataSource_FullMethodName, &pb.CreateDataSourceRequest{}, false},
+		{"datasource.begin", pb.OperRouter_BeginTransaction_FullMethodName, &pb.BeginTransactionRequest{}, false},
+		{"datasource.commit", pb.OperRouter_CommitTransaction_FullMethodName, &pb.CommitTransactionRequest{}, false},
+		{"datasource.rollback", pb.OperRouter_RollbackTransaction_FullMethodName, &pb.RollbackTransactionRequest{}, false},
+		{"kafka.publish", pb.OperRouter_KafkaPublish_FullMethodName, &pb.KafkaPublishRequest{}, false},
+		{"kafka.commit", pb.OperRouter_KafkaCommit_FullMethodName, &pb.KafkaCommitRequest{}, false},
+		{"mongo.insert", pb.OperRouter_MongoInsert_FullMethodName, &pb.MongoInsertRequest{}, false},
+		{"mongo.update", pb.OperRouter_MongoUpdate_FullMethodName, &pb.MongoUpdateRequest{}, false},
+		{"mongo.delete", pb.OperRouter_MongoDelete_FullMethodName, &pb.MongoDeleteRequest{}, false},
+		{"llm.create", pb.OperRouter_CreateLLM_FullMethodName, &pb.CreateLLMRequest{}, false},
+	}
+
+	for _, tt := range tests {
+		if got := idempotentHTTPMethods[tt.http]; got != tt.idempotent {
+			t.Errorf("HTTP %s idempotent = %v, want %v", tt.http, got, tt.idempotent)
+		}
+		if got := idempotentGRPCMethods[tt.grpc]; got != tt.idempotent {
+			t.Errorf("gRPC %s idempotent = %v, want %v", tt.grpc, got, tt.idempotent)
+		}
+		if got := idempotentFFIRequests[proto.MessageName(tt.ffi)]; got != tt.idempotent {
+			t.Errorf("FFI %s idempotent = %v, want %v", proto.MessageName(tt.ffi), got, tt.idempotent)
+		}
+	}
+}
+
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+func TestHTTPRetry(t *testing.T) {
+	type call func(ctx context.Context, c *HTTPClient) error
+
+	query := func(ctx context.Context, c *HTTPClient) error {
+		_, err := c.QueryDataSource(ctx, "db", "SELECT 1")
+		return err
+	}
+	execute := func(ctx context.Context, c *HTTPClient) error {
+		_, err := c.ExecuteDataSource(ctx, "db", "UPDATE t SET a = 1")
+		return err
+	}
+	insert := func(ctx context.Context, c *HTTPClient) error {
+		_, err := c.BulkInsertDataSource(ctx, "db", "t", []map[string]interface{}{{"a": 1}})
+		return err
+	}
+	commit := func(ctx context.Context, c *HTTPClient) error {
+		tx, err := c.BeginTx(ctx, "db", nil)
+		if err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+	publish := func(ctx context.Context, c *HTTPClient) error {
+		_, err := c.PublishKafka(ctx, "events", []KafkaRecord{{Topic: "t", Value: []byte("v")}})
+		return err
+	}
+
+	tests := []struct {
+		name     string
+		call     call
+		method   string
+		failures []int // status of each failed attempt; -1 for a JSON-RPC error
+		attempts int
+		ok       bool
+	}{
+		{"query retried on 503", query, "datasource.query", []int{503, 503, 503}, 3, false},
+		{"query retried on 429", query, "datasource.query", []int{429}, 2, true},
+		{"query retried on 502 and 504", query, "datasource.query", []int{502, 504}, 3, true},
+		{"query not retried on 500", query, "datasource.query", []int{500}, 1, false},
+		{"query not retried on a JSON-RPC error", query, "datasource.query", []int{-1}, 1, false},
+		{"execute not retried", execute, "datasource.execute", []int{503}, 1, false},
+		{"insert not retried", insert, "datasource.insert", []int{503}, 1, false},
+		{"commit not retried", commit, "datasource.commit", []int{503}, 1, false},
+		{"kafka publish not retried", publish, "kafka.publish", []int{503}, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req jsonrpcRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				if req.Method != tt.method {
+					// Calls leading up to the one under test succeed
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"success":true,"transaction_id":"tx1"}}`))
+					return
+				}
+				mu.Lock()
+				attempt := attempts
+				attempts++
+				mu.Unlock()
+				switch {
+				case attempt >= len(tt.failures):
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"success":true}}`))
+				case tt.failures[attempt] == -1:
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"busy"}}`))
+				default:
+					w.WriteHeader(tt.failures[attempt])
+				}
+			}))
+			defer srv.Close()
+
+			client, err := NewHTTPWithOptions(srv.URL, WithRetryPolicy(testRetryPolicy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = tt.call(context.Background(), client)
+			if (err == nil) != tt.ok {
+				t.Errorf("error = %v, want success %v", err, tt.ok)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("%d attempts, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHTTPRetryTransportErrors(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	resetErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name     string
+		err      error
+		execute  bool
+		attempts int
+	}{
+		{"query retried after a dial error", dialErr, false, 3},
+		{"execute retried after a dial error", dialErr, true, 3},
+		{"query retried after a reset", resetErr, false, 3},
+		{"execute not retried after a reset", resetErr, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewHTTPWithOptions("http://operrouter.invalid", WithRetryPolicy(testRetryPolicy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			attempts := 0
+			client.HTTP.Transport = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				attempts++
+				return nil, tt.err
+			})
+
+			if tt.execute {
+				_, err = client.ExecuteDataSource(context.Background(), "db", "UPDATE t SET a = 1")
+			} else {
+				_, err = client.QueryDataSource(context.Background(), "db", "SELECT 1")
+			}
+			if err == nil {
+				t.Error("call succeeded, want the transport error")
+			}
+			if attempts != tt.attempts {
+				t.Errorf("%d attempts, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestGRPCRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		code     codes.Code
+		header   bool // whether the server sent response headers
+		attempts int
+	}{
+		{"query retried when unavailable", pb.OperRouter_QueryDataSource_FullMethodName, codes.Unavailable, false, 3},
+		{"query not retried once the server answered", pb.OperRouter_QueryDataSource_FullMethodName, codes.Unavailable, true, 1},
+		{"query not retried on deadline", pb.OperRouter_QueryDataSource_FullMethodName, codes.DeadlineExceeded, false, 1},
+		{"query not retried on internal error", pb.OperRouter_QueryDataSource_FullMethodName, codes.Internal, false, 1},
+		{"execute not retried", pb.OperRouter_ExecuteDataSource_FullMethodName, codes.Unavailable, false, 1},
+		{"insert not retried", pb.OperRouter_InsertDataSource_FullMethodName, codes.Unavailable, false, 1},
+		{"commit not retried", pb.OperRouter_CommitTransaction_FullMethodName, codes.Unavailable, false, 1},
+		{"kafka publish not retried", pb.OperRouter_KafkaPublish_FullMethodName, codes.Unavailable, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := newClientOptions("gRPC", grpcSupportedOptions, []ClientOption{WithRetryPolicy(testRetryPolicy)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			attempts := 0
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				attempts++
+				if tt.header {
+					for _, opt := range opts {
+						if h, ok := opt.(grpc.HeaderCallOption); ok {
+							*h.HeaderAddr = metadata.Pairs("server", "seen")
+						}
+					}
+				}
+				return status.Error(tt.code, "failed")
+			}
+
+			err = o.unaryInterceptor(context.Background(), tt.method, nil, nil, nil, invoker)
+			if status.Code(err) != tt.code {
+				t.Errorf("error = %v, want code %s", err, tt.code)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("%d attempts, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	tests := []struct {
+		policy RetryPolicy
+		ok     bool
+	}{
+		{DefaultRetryPolicy, true},
+		{RetryPolicy{MaxAttempts: 1}, true},
+		{RetryPolicy{MaxAttempts: 0}, false},
+		{RetryPolicy{MaxAttempts: 2, InitialBackoff: -time.Second}, false},
+		{RetryPolicy{MaxAttempts: 2, MaxBackoff: -time.Second}, false},
+	}
+
+	for _, tt := range tests {
+		_, err := NewHTTPWithOptions("http://localhost", WithRetryPolicy(tt.policy))
+		if (err == nil) != tt.ok {
+			t.Errorf("WithRetryPolicy(%+v) error = %v, want ok %v", tt.policy, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrRedisNil is returned when a Redis key or field does not exist
+var ErrRedisNil = errors.New("operrouter: redis: nil")
+
+// Redis provides typed commands on a Redis DataSource.
+//
+// The query is the command name and the arguments are its arguments.
+// Read-only commands travel through QueryDataSource, which clients may
+// retry; every other command travels through ExecuteDataSource, which they
+// never retry, so an INCR or LPOP whose reply was lost is not applied twice.
+// The server replies with one row per reply element, holding the element in
+// the "value" column; a nil reply has no rows or a NULL value.
+type Redis struct {
+	client Client
+	name   string
+}
+
+// NewRedis returns the Redis commands of DataSource name
+func NewRedis(client Client, name string) *Redis {
+	return &Redis{client: client, name: name}
+}
+
+// redisReadOnly lists the commands sent with QueryDataSource; any command
+// not listed may change data and is sent with ExecuteDataSource
+var redisReadOnly = map[string]bool{
+	"GET":     true,
+	"MGET":    true,
+	"STRLEN":  true,
+	"EXISTS":  true,
+	"TYPE":    true,
+	"TTL":     true,
+	"PTTL":    true,
+	"HGET":    true,
+	"HMGET":   true,
+	"HGETALL": true,
+	"HEXISTS": true,
+	"HLEN":    true,
+	"HKEYS":   true,
+	"HVALS":   true,
+	"LRANGE":  true,
+	"LLEN":    true,
+	"LINDEX":  true,
+	"SCAN":    true,
+	"PING":    true,
+}
+
+// Do runs any command and returns the reply elements
+func (r *Redis) Do(ctx context.Context, command string, args ...interface{}) ([]interface{}, error) {
+	var (
+		success bool
+		message string
+		rows    []map[string]interface{}
+	)
+	if redisReadOnly[strings.ToUpper(command)] {
+		resp, err := r.client.QueryDataSource(ctx, r.name, command, args...)
+		if err != nil {
+			return nil, err
+		}
+		success, message, rows = resp.Success, resp.Message, resp.Rows
+	} else {
+		resp, err := r.client.ExecuteDataSource(ctx, r.name, command, args...)
+		if err != nil {
+			return nil, err
+		}
+		success, message, rows = resp.Success, resp.Message, resp.Rows
+	}
+	if !success {
+		return nil, fmt.Errorf("redis %s failed: %s", command, message)
+	}
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		values[i] = row["value"]
+	}
+	return values, nil
+}
+
+// Get returns the value of key, or ErrRedisNil
+func (r *Redis) Get(ctx context.Context, key string) (string, error) {
+	return r.doString(ctx, "GET", key)
+}
+
+// Set stores value at key. A ttl of 0 keeps the key forever; a ttl that is
+// not a whole number of milliseconds is rounded up.
+func (r *Redis) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	args := []interface{}{key, value}
+	if ttl > 0 {
+		args = append(args, "PX", redisMillis(ttl))
+	}
+	_, err := r.Do(ctx, "SET", args...)
+	return err
+}
+
+// Del deletes keys and returns how many existed
+func (r *Redis) Del(ctx context.Context, keys ...string) (int64, error) {
+	return r.doInt(ctx, "DEL", stringArgs(keys)...)
+}
+
+// Expire sets the time to live of key, rounded up to whole milliseconds; it
+// reports false if key does not exist
+func (r *Redis) Expire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	n, err := r.doInt(ctx, "PEXPIRE", key, redisMillis(ttl))
+	return n == 1, err
+}
+
+// TTL returns the remaining time to live of key: -1 when it has no expiry,
+// -2 when it does not exist (in nanoseconds, like Redis' own sentinels)
+func (r *Redis) TTL(ctx context.Context, key string) (time.Duration, error) {
+	n, err := r.doInt(ctx, "PTTL", key)
+	if err != nil || n < 0 {
+		return time.Duration(n), err
+	}
+	return time.Duration(n) * time.Millisecond, nil
+}
+
+// Incr increments the integer at key and returns the new value
+func (r *Redis) Incr(ctx context.Context, key string) (int64, error) {
+	return r.doInt(ctx, "INCR", key)
+}
+
+// IncrBy adds delta to the integer at key and returns the new value
+func (r *Redis) IncrBy(ctx context.Context, key string, delta int64) (int64, error) {
+	return r.doInt(ctx, "INCRBY", key, delta)
+}
+
+// HGet returns a hash field, or ErrRedisNil
+func (r *Redis) HGet(ctx context.Context, key, field string) (string, error) {
+	return r.doString(ctx, "HGET", key, field)
+}
+
+// HSet sets hash fields and returns how many were added
+func (r *Redis) HSet(ctx context.Context, key string, fields map[string]interface{}) (int64, error) {
+	args := make([]interface{}, 0, 1+2*len(fields))
+	args = append(args, key)
+	for field, value := range fields {
+		args = append(args, field, value)
+	}
+	return r.doInt(ctx, "HSET", args...)
+}
+
+// HGetAll returns every field of a hash; a missing key gives an empty map
+func (r *Redis) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	values, err := r.Do(ctx, "HGETALL", key)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := make(map[string]string)
+	// Servers may send the hash as one object instead of field/value pairs
+	if len(values) == 1 {
+		if object, ok := values[0].(map[string]interface{}); ok {
+			for field, value := range object {
+				if hash[field], err = redisString(value); err != nil {
+					return nil, fmt.Errorf("redis HGETALL: %w", err)
+				}
+			}
+			return hash, nil
+		}
+	}
+	if len(values)%2 != 0 {
+		return nil, fmt.Errorf("redis HGETALL: odd number of reply elements")
+	}
+	for i := 0; i < len(values); i += 2 {
+		field, err := redisString(values[i])
+		if err != nil {
+			return nil, fmt.Errorf("redis HGETALL: %w", err)
+		}
+		if hash[field], err = redisString(values[i+1]); err != nil {
+			return nil, fmt.Errorf("redis HGETALL: %w", err)
+		}
+	}
+	return hash, nil
+}
+
+// HDel deletes hash fields and returns how many existed
+func (r *Redis) HDel(ctx context.Context, key string, fields ...string) (int64, error) {
+	return r.doInt(ctx, "HDEL", append([]interface{}{key}, stringArgs(fields)...)...)
+}
+
+// LPush prepends values to a list and returns its new length
+func (r *Redis) LPush(ctx context.Context, key string, values ...interface{}) (int64, error) {
+	return r.doInt(ctx, "LPUSH", append([]interface{}{key}, values...)...)
+}
+
+// RPush appends values to a list and returns its new length
+func (r *Redis) RPush(ctx context.Context, key string, values ...interface{}) (int64, error) {
+	return r.doInt(ctx, "RPUSH", append([]interface{}{key}, values...)...)
+}
+
+// LPop removes and returns the first element of a list, or ErrRedisNil
+func (r *Redis) LPop(ctx context.Context, key string) (string, error) {
+	return r.doString(ctx, "LPOP", key)
+}
+
+// RPop removes and returns the last element of a list, or ErrRedisNil
+func (r *Redis) RPop(ctx context.Context, key string) (string, error) {
+	return r.doString(ctx, "RPOP", key)
+}
+
+// LRange returns the list elements from start to stop, inclusive
+func (r *Redis) LRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	values, err := r.Do(ctx, "LRANGE", key, start, stop)
+	if err != nil {
+		return nil, err
+	}
+	return redisStrings("LRANGE", values)
+}
+
+// LLen returns the length of a list
+func (r *Redis) LLen(ctx context.Context, key string) (int64, error) {
+	return r.doInt(ctx, "LLEN", key)
+}
+
+// Scan runs one SCAN step and returns the keys found and the next cursor,
+// which is 0 when the iteration is complete. match and count may be empty/0.
+func (r *Redis) Scan(ctx context.Context, cursor uint64, match string, count int64) ([]string, uint64, error) {
+	args := []interface{}{cursor}
+	if match != "" {
+		args = append(args, "MATCH", match)
+	}
+	if count > 0 {
+		args = append(args, "COUNT", count)
+	}
+
+	values, err := r.Do(ctx, "SCAN", args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(values) != 2 {
+		return nil, 0, fmt.Errorf("redis SCAN: expected cursor and keys, got %d reply elements", len(values))
+	}
+	nextStr, err := redisString(values[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("redis SCAN: %w", err)
+	}
+	next, err := strconv.ParseUint(nextStr, 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("redis SCAN: invalid cursor %q", nextStr)
+	}
+	keyValues, ok := values[1].([]interface{})
+	if !ok && values[1] != nil {
+		return nil, 0, fmt.Errorf("redis SCAN: keys are %T, not an array", values[1])
+	}
+	keys, err := redisStrings("SCAN", keyValues)
+	if err != nil {
+		return nil, 0, err
+	}
+	return keys, next, nil
+}
+
+// Keys iterates over every key matching match with SCAN, stopping at the
+// first error or when the loop breaks
+func (r *Redis) Keys(ctx context.Context, match string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var cursor uint64
+		for {
+			keys, next, err := r.Scan(ctx, cursor, match, 0)
+			if err != nil {
+				yield("", err)
+				return
+			}
+			for _, key := range keys {
+				if !yield(key, nil) {
+					return
+				}
+			}
+			if next == 0 {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// doString runs a command whose reply is a single string or nil
+func (r *Redis) doString(ctx context.Context, command string, args ...interface{}) (string, error) {
+	values, err := r.Do(ctx, command, args...)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 || values[0] == nil {
+		return "", ErrRedisNil
+	}
+	s, err := redisString(values[0])
+	if err != nil {
+		return "", fmt.Errorf("redis %s: %w", command, err)
+	}
+	return s, nil
+}
+
+// doInt runs a command whose reply is an integer
+func (r *Redis) doInt(ctx context.Context, command string, args ...interface{}) (int64, error) {
+	values, err := r.Do(ctx, command, args...)
+	if err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, fmt.Errorf("redis %s: empty reply", command)
+	}
+	reply := values[0]
+	if b, ok := reply.([]byte); ok {
+		reply = string(b)
+	}
+	n, err := scanInt(reply)
+	if err != nil {
+		return 0, fmt.Errorf("redis %s: reply %v is not an integer", command, values[0])
+	}
+	return n, nil
+}
+
+// redisMillis converts a positive ttl to milliseconds, rounding up so that a
+// sub-millisecond ttl does not become the invalid or key-deleting 0
+func redisMillis(ttl time.Duration) int64 {
+	if ttl <= 0 {
+		return ttl.Milliseconds()
+	}
+	return int64((ttl + time.Millisecond - 1) / time.Millisecond)
+}
+
+// redisString reads a bulk string reply element
+func redisString(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case string:
+		return val, nil
+	case []byte:
+		return string(val), nil
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("reply element is %T, not a string", v)
+	}
+}
+
+// redisStrings reads an array of bulk strings
+func redisStrings(command string, values []interface{}) ([]string, error) {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		s, err := redisString(v)
+		if err != nil {
+			return nil, fmt.Errorf("redis %s: %w", command, err)
+		}
+		strs[i] = s
+	}
+	return strs, nil
+}
+
+// stringArgs converts strings to command arguments
+func stringArgs(strs []string) []interface{} {
+	args := make([]interface{}, len(strs))
+	for i, s := range strs {
+		args[i] = s
+	}
+	return args
+}
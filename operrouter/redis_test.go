@@ -0,0 +1,317 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// redisClient answers every command with the reply elements in values,
+// recording which call carried it
+type redisClient struct {
+	Client
+	values []interface{}
+	fail   string
+	calls  []string
+	args   []interface{}
+}
+
+func (c *redisClient) rows() []map[string]interface{} {
+	rows := make([]map[string]interface{}, len(c.values))
+	for i, v := range c.values {
+		rows[i] = map[string]interface{}{"value": v}
+	}
+	return rows
+}
+
+func (c *redisClient) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceQueryResponse, error) {
+	c.calls = append(c.calls, "query "+query)
+	c.args = args
+	return &DataSourceQueryResponse{Success: c.fail == "", Message: c.fail, Rows: c.rows()}, nil
+}
+
+func (c *redisClient) ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceExecuteResponse, error) {
+	c.calls = append(c.calls, "execute "+query)
+	c.args = args
+	return &DataSourceExecuteResponse{Success: c.fail == "", Message: c.fail, Rows: c.rows()}, nil
+}
+
+func TestRedisCommands(t *testing.T) {
+	type call func(ctx context.Context, r *Redis) (interface{}, error)
+
+	tests := []struct {
+		name     string
+		values   []interface{}
+		call     call
+		wantCall string
+		wantArgs []interface{}
+		want     interface{}
+		err      string
+	}{
+		{
+			"get", []interface{}{"alice"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Get(ctx, "k") },
+			"query GET", []interface{}{"k"}, "alice", "",
+		},
+		{
+			"get bytes", []interface{}{[]byte("alice")},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Get(ctx, "k") },
+			"query GET", []interface{}{"k"}, "alice", "",
+		},
+		{
+			"get missing", nil,
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Get(ctx, "k") },
+			"query GET", []interface{}{"k"}, "", "operrouter: redis: nil",
+		},
+		{
+			"get null", []interface{}{nil},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Get(ctx, "k") },
+			"query GET", []interface{}{"k"}, "", "operrouter: redis: nil",
+		},
+		{
+			"set", []interface{}{"OK"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return nil, r.Set(ctx, "k", "v", 0) },
+			"execute SET", []interface{}{"k", "v"}, nil, "",
+		},
+		{
+			"set with ttl", []interface{}{"OK"},
+			func(ctx context.Context, r *Redis) (interface{}, error) {
+				return nil, r.Set(ctx, "k", "v", 2*time.Second)
+			},
+			"execute SET", []interface{}{"k", "v", "PX", int64(2000)}, nil, "",
+		},
+		{
+			"set with sub-millisecond ttl", []interface{}{"OK"},
+			func(ctx context.Context, r *Redis) (interface{}, error) {
+				return nil, r.Set(ctx, "k", "v", time.Microsecond)
+			},
+			"execute SET", []interface{}{"k", "v", "PX", int64(1)}, nil, "",
+		},
+		{
+			"set with fractional ttl", []interface{}{"OK"},
+			func(ctx context.Context, r *Redis) (interface{}, error) {
+				return nil, r.Set(ctx, "k", "v", 1500*time.Microsecond)
+			},
+			"execute SET", []interface{}{"k", "v", "PX", int64(2)}, nil, "",
+		},
+		{
+			"incr", []interface{}{int64(3)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Incr(ctx, "n") },
+			"execute INCR", []interface{}{"n"}, int64(3), "",
+		},
+		{
+			"incrby string reply", []interface{}{"-4"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.IncrBy(ctx, "n", -5) },
+			"execute INCRBY", []interface{}{"n", int64(-5)}, int64(-4), "",
+		},
+		{
+			"incr non-integer reply", []interface{}{"1.5"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Incr(ctx, "n") },
+			"execute INCR", []interface{}{"n"}, int64(0), "redis INCR: reply 1.5 is not an integer",
+		},
+		{
+			"incr empty reply", nil,
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Incr(ctx, "n") },
+			"execute INCR", []interface{}{"n"}, int64(0), "redis INCR: empty reply",
+		},
+		{
+			"del", []interface{}{int64(2)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Del(ctx, "a", "b") },
+			"execute DEL", []interface{}{"a", "b"}, int64(2), "",
+		},
+		{
+			"expire", []interface{}{int64(1)},
+			func(ctx context.Context, r *Redis) (interface{}, error) {
+				return r.Expire(ctx, "k", 100*time.Microsecond)
+			},
+			"execute PEXPIRE", []interface{}{"k", int64(1)}, true, "",
+		},
+		{
+			"expire missing key", []interface{}{int64(0)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Expire(ctx, "k", time.Second) },
+			"execute PEXPIRE", []interface{}{"k", int64(1000)}, false, "",
+		},
+		{
+			"ttl", []interface{}{int64(1500)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.TTL(ctx, "k") },
+			"query PTTL", []interface{}{"k"}, 1500 * time.Millisecond, "",
+		},
+		{
+			"ttl without expiry", []interface{}{int64(-1)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.TTL(ctx, "k") },
+			"query PTTL", []interface{}{"k"}, time.Duration(-1), "",
+		},
+		{
+			"hget", []interface{}{float64(30)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.HGet(ctx, "h", "age") },
+			"query HGET", []interface{}{"h", "age"}, "30", "",
+		},
+		{
+			"hset", []interface{}{int64(1)},
+			func(ctx context.Context, r *Redis) (interface{}, error) {
+				return r.HSet(ctx, "h", map[string]interface{}{"name": "alice"})
+			},
+			"execute HSET", []interface{}{"h", "name", "alice"}, int64(1), "",
+		},
+		{
+			"hgetall pairs", []interface{}{"name", "alice", "age", int64(30)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.HGetAll(ctx, "h") },
+			"query HGETALL", []interface{}{"h"}, map[string]string{"name": "alice", "age": "30"}, "",
+		},
+		{
+			"hgetall object", []interface{}{map[string]interface{}{"name": "alice"}},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.HGetAll(ctx, "h") },
+			"query HGETALL", []interface{}{"h"}, map[string]string{"name": "alice"}, "",
+		},
+		{
+			"hgetall missing key", nil,
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.HGetAll(ctx, "h") },
+			"query HGETALL", []interface{}{"h"}, map[string]string{}, "",
+		},
+		{
+			"hgetall odd reply", []interface{}{"name"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.HGetAll(ctx, "h") },
+			"query HGETALL", []interface{}{"h"}, map[string]string(nil), "odd number of reply elements",
+		},
+		{
+			"hdel", []interface{}{int64(1)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.HDel(ctx, "h", "name") },
+			"execute HDEL", []interface{}{"h", "name"}, int64(1), "",
+		},
+		{
+			"lpush", []interface{}{int64(2)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.LPush(ctx, "l", "a", 1) },
+			"execute LPUSH", []interface{}{"l", "a", 1}, int64(2), "",
+		},
+		{
+			"rpush", []interface{}{int64(3)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.RPush(ctx, "l", "b") },
+			"execute RPUSH", []interface{}{"l", "b"}, int64(3), "",
+		},
+		{
+			"lpop", []interface{}{"a"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.LPop(ctx, "l") },
+			"execute LPOP", []interface{}{"l"}, "a", "",
+		},
+		{
+			"rpop empty list", []interface{}{nil},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.RPop(ctx, "l") },
+			"execute RPOP", []interface{}{"l"}, "", "operrouter: redis: nil",
+		},
+		{
+			"lrange", []interface{}{"a", int64(1)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.LRange(ctx, "l", 0, -1) },
+			"query LRANGE", []interface{}{"l", int64(0), int64(-1)}, []string{"a", "1"}, "",
+		},
+		{
+			"lrange non-string element", []interface{}{true},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.LRange(ctx, "l", 0, -1) },
+			"query LRANGE", []interface{}{"l", int64(0), int64(-1)}, []string(nil), "redis LRANGE: reply element is bool, not a string",
+		},
+		{
+			"llen", []interface{}{int64(4)},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.LLen(ctx, "l") },
+			"query LLEN", []interface{}{"l"}, int64(4), "",
+		},
+		{
+			"unknown command is not retried", []interface{}{"OK"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Do(ctx, "flushdb") },
+			"execute flushdb", nil, []interface{}{"OK"}, "",
+		},
+		{
+			"read-only command in lower case", []interface{}{"PONG"},
+			func(ctx context.Context, r *Redis) (interface{}, error) { return r.Do(ctx, "ping") },
+			"query ping", nil, []interface{}{"PONG"}, "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &redisClient{values: tt.values}
+			got, err := tt.call(context.Background(), NewRedis(c, "cache"))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("error = %v, want %q", err, tt.err)
+				}
+			} else if err != nil {
+				t.Errorf("error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("result = %#v, want %#v", got, tt.want)
+			}
+			if want := []string{tt.wantCall}; !reflect.DeepEqual(c.calls, want) {
+				t.Errorf("calls = %q, want %q", c.calls, want)
+			}
+			if !reflect.DeepEqual(c.args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", c.args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestRedisFailure(t *testing.T) {
+	c := &redisClient{fail: "WRONGTYPE Operation against a key holding the wrong kind of value"}
+	r := NewRedis(c, "cache")
+	if _, err := r.LPush(context.Background(), "k", "a"); err == nil ||
+		err.Error() != "redis LPUSH failed: WRONGTYPE Operation against a key holding the wrong kind of value" {
+		t.Errorf("LPush error = %v, want the server message", err)
+	}
+	if _, err := r.Get(context.Background(), "k"); errors.Is(err, ErrRedisNil) || err == nil {
+		t.Errorf("Get error = %v, want the server failure rather than ErrRedisNil", err)
+	}
+}
+
+func TestRedisKeys(t *testing.T) {
+	pages := [][]interface{}{
+		{"7", []interface{}{"a", "b"}},
+		{"0", []interface{}{"c"}},
+	}
+	c := &scanClient{pages: pages}
+	var keys []string
+	for key, err := range NewRedis(c, "cache").Keys(context.Background(), "*") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+	}
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("keys = %q, want a b c", keys)
+	}
+	wantCursors := []interface{}{uint64(0), uint64(7)}
+	if !reflect.DeepEqual(c.cursors, wantCursors) {
+		t.Errorf("cursors = %v, want %v", c.cursors, wantCursors)
+	}
+
+	// Breaking out of the loop stops the scan
+	c = &scanClient{pages: pages}
+	for range NewRedis(c, "cache").Keys(context.Background(), "*") {
+		break
+	}
+	if len(c.cursors) != 1 {
+		t.Errorf("%d SCAN calls after break, want 1", len(c.cursors))
+	}
+
+	c = &scanClient{pages: [][]interface{}{{"x", nil}}}
+	for _, err := range NewRedis(c, "cache").Keys(context.Background(), "*") {
+		if err == nil || !strings.Contains(err.Error(), `invalid cursor "x"`) {
+			t.Errorf("Keys error = %v, want an invalid cursor", err)
+		}
+	}
+}
+
+// scanClient answers SCAN with one page per call
+type scanClient struct {
+	Client
+	pages   [][]interface{}
+	cursors []interface{}
+}
+
+func (c *scanClient) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*DataSourceQueryResponse, error) {
+	c.cursors = append(c.cursors, args[0])
+	page := c.pages[0]
+	c.pages = c.pages[1:]
+	return &DataSourceQueryResponse{Success: true, Rows: []map[string]interface{}{{"value": page[0]}, {"value": page[1]}}}, nil
+}
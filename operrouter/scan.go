@@ -0,0 +1,404 @@
+package operrouter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// QueryInto runs a query on any Client and scans every row into a T.
+// T is usually a struct whose fields are matched to columns by their `db`
+// tag (see ScanRow); a non-struct T reads rows with exactly one column.
+func QueryInto[T any](ctx context.Context, c Client, name string, query string, args ...interface{}) ([]T, error) {
+	resp, err := c.QueryDataSource(ctx, name, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("query datasource failed: %s", resp.Message)
+	}
+
+	results := make([]T, len(resp.Rows))
+	for i, row := range resp.Rows {
+		if err := ScanRow(row, &results[i]); err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+	return results, nil
+}
+
+// ScanRow copies a query row into dest, which must be a non-nil pointer.
+//
+// For a struct, each column is stored in the field tagged `db:"column"`, or
+// failing that the field whose name matches the column ignoring case; fields
+// tagged `db:"-"` are skipped and columns without a field are ignored.
+// Embedded structs are flattened. NULL is only accepted by pointer, interface,
+// map, slice and sql.Scanner fields. time.Time fields accept RFC 3339 and SQL
+// date strings, and struct, map or slice fields accept documents, arrays and
+// JSON text.
+//
+// For any other type the row must have exactly one column.
+func ScanRow(row map[string]interface{}, dest interface{}) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("scan: destination must be a non-nil pointer, got %T", dest)
+	}
+	elem := rv.Elem()
+
+	if elem.Kind() == reflect.Pointer && elem.Type().Elem().Kind() == reflect.Struct {
+		if elem.IsNil() {
+			elem.Set(reflect.New(elem.Type().Elem()))
+		}
+		if !isScanLeaf(elem.Elem()) {
+			return ScanRow(row, elem.Interface())
+		}
+	}
+
+	if elem.Kind() != reflect.Struct || isScanLeaf(elem) {
+		if len(row) != 1 {
+			return fmt.Errorf("scan: %d columns cannot be scanned into %s", len(row), elem.Type())
+		}
+		for column, value := range row {
+			if err := assignValue(elem, value); err != nil {
+				return fmt.Errorf("scan: column %q: %w", column, err)
+			}
+		}
+		return nil
+	}
+
+	fields := structFields(elem.Type())
+	for column, value := range row {
+		field, ok := fields.lookup(column)
+		if !ok {
+			continue
+		}
+		dst := fieldByIndexAlloc(elem, field.index)
+		if err := assignValue(dst, value); err != nil {
+			return fmt.Errorf("scan: column %q into field %s: %w", column, field.name, err)
+		}
+	}
+	return nil
+}
+
+// scanField is a struct field reachable from the scanned type
+type scanField struct {
+	name  string
+	index []int
+}
+
+// scanFields maps column names to fields
+type scanFields struct {
+	exact map[string]scanField
+	fold  map[string]scanField
+}
+
+// lookup finds the field for column, preferring an exact match
+func (f *scanFields) lookup(column string) (scanField, bool) {
+	if field, ok := f.exact[column]; ok {
+		return field, true
+	}
+	field, ok := f.fold[strings.ToLower(column)]
+	return field, ok
+}
+
+var scanFieldCache sync.Map // reflect.Type -> *scanFields
+
+// structFields returns the column mapping of struct type t
+func structFields(t reflect.Type) *scanFields {
+	if cached, ok := scanFieldCache.Load(t); ok {
+		return cached.(*scanFields)
+	}
+	fields := &scanFields{
+		exact: make(map[string]scanField),
+		fold:  make(map[string]scanField),
+	}
+	collectFields(t, nil, fields)
+	cached, _ := scanFieldCache.LoadOrStore(t, fields)
+	return cached.(*scanFields)
+}
+
+// collectFields walks t, flattening embedded structs. Shallower fields win
+// over deeper ones, as with Go's own field promotion.
+func collectFields(t reflect.Type, index []int, fields *scanFields) {
+	var embedded []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup("db")
+		if tag == "-" {
+			continue
+		}
+		if tag, _, _ = strings.Cut(tag, ","); tag == "" {
+			hasTag = false
+		}
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && !hasTag && ft.Kind() == reflect.Struct && ft != reflect.TypeOf(time.Time{}) {
+			// An unexported embedded pointer cannot be allocated
+			if sf.IsExported() || sf.Type.Kind() != reflect.Pointer {
+				embedded = append(embedded, sf)
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := sf.Name
+		if hasTag {
+			name = tag
+		}
+		field := scanField{name: sf.Name, index: append(append([]int(nil), index...), i)}
+		if _, ok := fields.exact[name]; !ok {
+			fields.exact[name] = field
+		}
+		if _, ok := fields.fold[strings.ToLower(name)]; !ok {
+			fields.fold[strings.ToLower(name)] = field
+		}
+	}
+
+	for _, sf := range embedded {
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		collectFields(ft, append(append([]int(nil), index...), sf.Index...), fields)
+	}
+}
+
+// fieldByIndexAlloc is reflect.Value.FieldByIndex, allocating nil embedded
+// struct pointers on the way
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// isScanLeaf reports whether a struct value is scanned as a single value
+func isScanLeaf(v reflect.Value) bool {
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+	_, ok := v.Addr().Interface().(sql.Scanner)
+	return ok
+}
+
+// sqlDateLayouts are the time formats accepted besides RFC 3339
+var sqlDateLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// assignValue stores src, a value as returned by ValueToGo, into dst
+func assignValue(dst reflect.Value, src interface{}) error {
+	if dst.CanAddr() {
+		if scanner, ok := dst.Addr().Interface().(sql.Scanner); ok {
+			return scanner.Scan(src)
+		}
+	}
+
+	if src == nil {
+		switch dst.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+			dst.SetZero()
+			return nil
+		}
+		return fmt.Errorf("cannot store NULL in %s, use a pointer", dst.Type())
+	}
+
+	switch dst.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if err := assignValue(elem.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case reflect.Interface:
+		srcValue := reflect.ValueOf(src)
+		if !srcValue.Type().AssignableTo(dst.Type()) {
+			return mismatch(src, dst)
+		}
+		dst.Set(srcValue)
+		return nil
+	}
+
+	if dst.Type() == reflect.TypeOf(time.Time{}) {
+		return assignTime(dst, src)
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		switch s := src.(type) {
+		case string:
+			dst.SetString(s)
+		case []byte:
+			dst.SetString(string(s))
+		default:
+			return mismatch(src, dst)
+		}
+		return nil
+
+	case reflect.Bool:
+		switch b := src.(type) {
+		case bool:
+			dst.SetBool(b)
+		case int64:
+			// MySQL reports BOOLEAN columns as TINYINT
+			if b != 0 && b != 1 {
+				return mismatch(src, dst)
+			}
+			dst.SetBool(b == 1)
+		default:
+			return mismatch(src, dst)
+		}
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := scanInt(src)
+		if err != nil || dst.OverflowInt(n) {
+			return mismatch(src, dst)
+		}
+		dst.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := scanInt(src)
+		if err != nil || n < 0 || dst.OverflowUint(uint64(n)) {
+			return mismatch(src, dst)
+		}
+		dst.SetUint(uint64(n))
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch v := src.(type) {
+		case float64:
+			f = v
+		case int64:
+			f = float64(v)
+		case string:
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return mismatch(src, dst)
+			}
+			f = parsed
+		default:
+			return mismatch(src, dst)
+		}
+		if dst.OverflowFloat(f) {
+			return mismatch(src, dst)
+		}
+		dst.SetFloat(f)
+		return nil
+
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() == reflect.Uint8 {
+			switch b := src.(type) {
+			case []byte:
+				dst.SetBytes(append([]byte(nil), b...))
+				return nil
+			case string:
+				dst.SetBytes([]byte(b))
+				return nil
+			}
+		}
+		return assignDocument(dst, src)
+
+	case reflect.Struct, reflect.Map, reflect.Array:
+		return assignDocument(dst, src)
+	}
+
+	return mismatch(src, dst)
+}
+
+// scanInt reads an integer column, accepting whole floats and numeric strings
+func scanInt(src interface{}) (int64, error) {
+	switch v := src.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, fmt.Errorf("not an integer")
+		}
+		return int64(v), nil
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	}
+	return 0, fmt.Errorf("not an integer")
+}
+
+// assignTime parses time columns
+func assignTime(dst reflect.Value, src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case time.Time:
+		dst.Set(reflect.ValueOf(v))
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return mismatch(src, dst)
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		dst.Set(reflect.ValueOf(t))
+		return nil
+	}
+	for _, layout := range sqlDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			dst.Set(reflect.ValueOf(t))
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse %q as a time", s)
+}
+
+// assignDocument stores a document, array or JSON text column into a struct,
+// map, slice or array by way of encoding/json
+func assignDocument(dst reflect.Value, src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		data = encoded
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return mismatch(src, dst)
+	}
+
+	if err := json.Unmarshal(data, dst.Addr().Interface()); err != nil {
+		return fmt.Errorf("cannot decode %T into %s: %w", src, dst.Type(), err)
+	}
+	return nil
+}
+
+// mismatch describes a column value that does not fit its field
+func mismatch(src interface{}, dst reflect.Value) error {
+	return fmt.Errorf("cannot store %T value %v in %s", src, src, dst.Type())
+}
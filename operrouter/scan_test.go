@@ -0,0 +1,162 @@
+package operrouter
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type scanBase struct {
+	ID      int64     `db:"id"`
+	Created time.Time `db:"created_at"`
+}
+
+// ScanAudit is exported so that scanUser can embed it by pointer
+type ScanAudit struct {
+	UpdatedBy string `db:"updated_by"`
+}
+
+type scanUser struct {
+	scanBase
+	*ScanAudit
+	Name     string
+	Email    *string        `db:"email"`
+	Active   bool           `db:"active"`
+	Score    float32        `db:"score"`
+	Age      uint8          `db:"age"`
+	Nick     sql.NullString `db:"nick"`
+	Tags     []string       `db:"tags"`
+	Settings map[string]int `db:"settings"`
+	Secret   string         `db:"-"`
+	Data     []byte         `db:"data"`
+}
+
+// scanShadow has a field of its own that shadows the embedded ID
+type scanShadow struct {
+	scanBase
+	ID string `db:"id"`
+}
+
+func TestScanRow(t *testing.T) {
+	created := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	email := "a@example.com"
+
+	tests := []struct {
+		name string
+		row  map[string]interface{}
+		dest interface{}
+		want interface{}
+	}{
+		{
+			"struct with embedded structs",
+			map[string]interface{}{
+				"id": int64(1), "created_at": "2024-05-06T07:08:09Z", "updated_by": "admin",
+				"NAME": "Ann", "email": email, "active": true, "score": 1.5, "age": int64(30),
+				"nick": "annie", "tags": []interface{}{"a", "b"}, "settings": `{"x": 1}`,
+				"Secret": "ignored", "unknown": "ignored", "data": "raw",
+			},
+			&scanUser{},
+			&scanUser{
+				scanBase: scanBase{ID: 1, Created: created}, ScanAudit: &ScanAudit{UpdatedBy: "admin"},
+				Name: "Ann", Email: &email, Active: true, Score: 1.5, Age: 30,
+				Nick: sql.NullString{String: "annie", Valid: true}, Tags: []string{"a", "b"},
+				Settings: map[string]int{"x": 1}, Data: []byte("raw"),
+			},
+		},
+		{
+			"NULL into nullable fields",
+			map[string]interface{}{"email": nil, "nick": nil, "tags": nil, "settings": nil},
+			&scanUser{Email: &email, Tags: []string{"old"}},
+			&scanUser{},
+		},
+		{
+			"MySQL TINYINT booleans",
+			map[string]interface{}{"active": int64(1)},
+			&scanUser{},
+			&scanUser{Active: true},
+		},
+		{
+			"SQL date strings",
+			map[string]interface{}{"created_at": "2024-05-06 07:08:09"},
+			&scanBase{},
+			&scanBase{Created: created},
+		},
+		{
+			"whole floats and numeric strings into integers",
+			map[string]interface{}{"id": 12.0, "age": "7"},
+			&scanUser{},
+			&scanUser{scanBase: scanBase{ID: 12}, Age: 7},
+		},
+		{
+			"shallower field wins",
+			map[string]interface{}{"id": "outer"},
+			&scanShadow{},
+			&scanShadow{ID: "outer"},
+		},
+		{
+			"pointer to struct is allocated",
+			map[string]interface{}{"id": int64(3)},
+			new(*scanBase),
+			func() **scanBase { b := &scanBase{ID: 3}; return &b }(),
+		},
+		{
+			"single column into scalar",
+			map[string]interface{}{"count": int64(42)},
+			new(int),
+			func() *int { n := 42; return &n }(),
+		},
+		{
+			"single column into time",
+			map[string]interface{}{"now": "2024-05-06"},
+			new(time.Time),
+			func() *time.Time { d := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC); return &d }(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ScanRow(tt.row, tt.dest); err != nil {
+				t.Fatalf("ScanRow: %v", err)
+			}
+			if !reflect.DeepEqual(tt.dest, tt.want) {
+				t.Errorf("ScanRow = %#v, want %#v", tt.dest, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanRowErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		row  map[string]interface{}
+		dest interface{}
+		want string
+	}{
+		{"non-pointer", map[string]interface{}{"id": int64(1)}, scanBase{}, "non-nil pointer"},
+		{"nil pointer", map[string]interface{}{"id": int64(1)}, (*scanBase)(nil), "non-nil pointer"},
+		{"NULL into non-pointer", map[string]interface{}{"id": nil}, &scanBase{}, "cannot store NULL in int64, use a pointer"},
+		{"NULL into string", map[string]interface{}{"updated_by": nil}, &ScanAudit{}, "cannot store NULL"},
+		{"MySQL boolean out of range", map[string]interface{}{"active": int64(2)}, &scanUser{}, "cannot store int64 value 2 in bool"},
+		{"string into bool", map[string]interface{}{"active": "yes"}, &scanUser{}, "in bool"},
+		{"integer overflow", map[string]interface{}{"age": int64(300)}, &scanUser{}, "in uint8"},
+		{"negative unsigned", map[string]interface{}{"age": int64(-1)}, &scanUser{}, "in uint8"},
+		{"fractional integer", map[string]interface{}{"id": 1.5}, &scanBase{}, "in int64"},
+		{"bad time", map[string]interface{}{"created_at": "yesterday"}, &scanBase{}, `cannot parse "yesterday" as a time`},
+		{"bad document", map[string]interface{}{"settings": "{"}, &scanUser{}, "cannot decode"},
+		{"several columns into scalar", map[string]interface{}{"a": int64(1), "b": int64(2)}, new(int64), "2 columns"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ScanRow(tt.row, tt.dest)
+			if err == nil {
+				t.Fatalf("ScanRow = %#v, want error", tt.dest)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q does not mention %q", err, tt.want)
+			}
+		})
+	}
+}
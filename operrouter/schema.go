@@ -0,0 +1,138 @@
+package operrouter
+
+import (
+	"strings"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// TableKind tells tables, views and document collections apart
+type TableKind string
+
+const (
+	TableKindTable      TableKind = "table"
+	TableKindView       TableKind = "view"
+	TableKindCollection TableKind = "collection"
+)
+
+// TableInfo names a table or collection
+type TableInfo struct {
+	Name string
+	// Schema is the schema or database holding the table; empty when the
+	// DataSource has none
+	Schema string
+	// Kind is empty when the server does not report it
+	Kind TableKind
+}
+
+// ColumnInfo describes one column, or one field of a document collection
+type ColumnInfo struct {
+	Name string
+	// Type is the type as the database reports it, e.g. "varchar(255)". For
+	// document DataSources it is the value type seen in sampled documents.
+	Type       string
+	Nullable   bool
+	PrimaryKey bool
+	// Default is the default expression, or nil when the column has none
+	Default *string
+}
+
+// TableSchema describes a table and its columns, in table order
+type TableSchema struct {
+	TableInfo
+	Columns []ColumnInfo
+}
+
+// PrimaryKey returns the names of the primary key columns, in table order
+func (t *TableSchema) PrimaryKey() []string {
+	var names []string
+	for _, column := range t.Columns {
+		if column.PrimaryKey {
+			names = append(names, column.Name)
+		}
+	}
+	return names
+}
+
+// Column returns the column called name, or nil
+func (t *TableSchema) Column(name string) *ColumnInfo {
+	for i := range t.Columns {
+		if t.Columns[i].Name == name {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+// ListTablesResponse holds the tables or collections of a DataSource
+type ListTablesResponse struct {
+	Success bool
+	Tables  []TableInfo
+	Message string
+}
+
+// DescribeTableResponse holds the schema of one table or collection
+type DescribeTableResponse struct {
+	Success bool
+	// Table is nil when Success is false
+	Table   *TableSchema
+	Message string
+}
+
+// tableKindFromProto maps the proto enum onto TableKind
+func tableKindFromProto(kind pb.TableKind) TableKind {
+	if kind == pb.TableKind_TABLE_KIND_UNSPECIFIED {
+		return ""
+	}
+	// "table", "view" or "collection"
+	return TableKind(strings.ToLower(strings.TrimPrefix(kind.String(), "TABLE_KIND_")))
+}
+
+// tableInfoFromProto converts a TableInfo
+func tableInfoFromProto(t *pb.TableInfo) TableInfo {
+	return TableInfo{
+		Name:   t.GetName(),
+		Schema: t.GetSchema(),
+		Kind:   tableKindFromProto(t.GetKind()),
+	}
+}
+
+// listTablesResponse converts a ListTablesResponse
+func listTablesResponse(resp *pb.ListTablesResponse) *ListTablesResponse {
+	tables := make([]TableInfo, len(resp.Tables))
+	for i, t := range resp.Tables {
+		tables[i] = tableInfoFromProto(t)
+	}
+	return &ListTablesResponse{
+		Success: resp.Success,
+		Tables:  tables,
+		Message: resp.Error,
+	}
+}
+
+// describeTableResponse converts a DescribeTableResponse
+func describeTableResponse(resp *pb.DescribeTableResponse) *DescribeTableResponse {
+	result := &DescribeTableResponse{
+		Success: resp.Success,
+		Message: resp.Error,
+	}
+	if !resp.Success {
+		return result
+	}
+
+	table := &TableSchema{
+		TableInfo: tableInfoFromProto(resp.Table),
+		Columns:   make([]ColumnInfo, len(resp.Columns)),
+	}
+	for i, c := range resp.Columns {
+		table.Columns[i] = ColumnInfo{
+			Name:       c.Name,
+			Type:       c.Type,
+			Nullable:   c.Nullable,
+			PrimaryKey: c.PrimaryKey,
+			Default:    c.DefaultValue,
+		}
+	}
+	result.Table = table
+	return result
+}
@@ -0,0 +1,169 @@
+package operrouter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+func TestTableKindFromProto(t *testing.T) {
+	tests := []struct {
+		kind pb.TableKind
+		want TableKind
+	}{
+		{pb.TableKind_TABLE_KIND_UNSPECIFIED, ""},
+		{pb.TableKind_TABLE_KIND_TABLE, TableKindTable},
+		{pb.TableKind_TABLE_KIND_VIEW, TableKindView},
+		{pb.TableKind_TABLE_KIND_COLLECTION, TableKindCollection},
+	}
+
+	for _, tt := range tests {
+		if got := tableKindFromProto(tt.kind); got != tt.want {
+			t.Errorf("tableKindFromProto(%s) = %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeTableResponse(t *testing.T) {
+	def := "now()"
+	tests := []struct {
+		name string
+		resp *pb.DescribeTableResponse
+		want *DescribeTableResponse
+	}{
+		{
+			"columns in table order",
+			&pb.DescribeTableResponse{
+				Success: true,
+				Table:   &pb.TableInfo{Name: "orders", Schema: "public", Kind: pb.TableKind_TABLE_KIND_TABLE},
+				Columns: []*pb.ColumnInfo{
+					{Name: "id", Type: "bigint", PrimaryKey: true},
+					{Name: "created", Type: "timestamptz", DefaultValue: &def},
+					{Name: "note", Type: "text", Nullable: true},
+				},
+			},
+			&DescribeTableResponse{Success: true, Table: &TableSchema{
+				TableInfo: TableInfo{Name: "orders", Schema: "public", Kind: TableKindTable},
+				Columns: []ColumnInfo{
+					{Name: "id", Type: "bigint", PrimaryKey: true},
+					{Name: "created", Type: "timestamptz", Default: &def},
+					{Name: "note", Type: "text", Nullable: true},
+				},
+			}},
+		},
+		{
+			"failure has no table",
+			&pb.DescribeTableResponse{Error: `relation "orderz" does not exist`, Table: &pb.TableInfo{Name: "orderz"}},
+			&DescribeTableResponse{Message: `relation "orderz" does not exist`},
+		},
+		{
+			"collection without table info",
+			&pb.DescribeTableResponse{Success: true, Columns: []*pb.ColumnInfo{{Name: "_id", Type: "objectId", PrimaryKey: true}}},
+			&DescribeTableResponse{Success: true, Table: &TableSchema{
+				Columns: []ColumnInfo{{Name: "_id", Type: "objectId", PrimaryKey: true}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeTableResponse(tt.resp); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("describeTableResponse = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableSchemaLookup(t *testing.T) {
+	table := &TableSchema{Columns: []ColumnInfo{
+		{Name: "tenant", PrimaryKey: true},
+		{Name: "name"},
+		{Name: "id", PrimaryKey: true},
+	}}
+
+	if got, want := table.PrimaryKey(), []string{"tenant", "id"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PrimaryKey = %q, want %q", got, want)
+	}
+	if c := table.Column("name"); c == nil || c != &table.Columns[1] {
+		t.Errorf("Column(name) = %v, want the second column", c)
+	}
+	if c := table.Column("missing"); c != nil {
+		t.Errorf("Column(missing) = %+v, want nil", c)
+	}
+	if got := (&TableSchema{}).PrimaryKey(); got != nil {
+		t.Errorf("PrimaryKey without columns = %q, want nil", got)
+	}
+}
+
+func TestHTTPSchemaIntrospection(t *testing.T) {
+	var methods []string
+	var params []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string                 `json:"method"`
+			Params map[string]interface{} `json:"params"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		methods = append(methods, req.Method)
+		params = append(params, req.Params)
+		switch req.Method {
+		case "datasource.list_tables":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"success":true,"tables":[` +
+				`{"name":"orders","schema":"public","kind":"TABLE"},{"name":"recent","schema":"public","kind":"view"},{"name":"legacy"}]}}`))
+		case "datasource.describe_table":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"success":true,"table":{"name":"orders","schema":"sales","kind":"table"},` +
+				`"columns":[{"name":"id","type":"bigint","primary_key":true},{"name":"status","type":"text","nullable":true,"default_value":"'new'"}]}}`))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewHTTPWithOptions(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	tables, err := client.ListTables(ctx, "db", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTables := []TableInfo{
+		{Name: "orders", Schema: "public", Kind: TableKindTable},
+		{Name: "recent", Schema: "public", Kind: TableKindView},
+		{Name: "legacy"},
+	}
+	if !tables.Success || !reflect.DeepEqual(tables.Tables, wantTables) {
+		t.Errorf("ListTables = %+v, want %+v", tables, wantTables)
+	}
+
+	schema, err := client.DescribeTable(ctx, "db", "sales", "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	def := "'new'"
+	wantSchema := &TableSchema{
+		TableInfo: TableInfo{Name: "orders", Schema: "sales", Kind: TableKindTable},
+		Columns: []ColumnInfo{
+			{Name: "id", Type: "bigint", PrimaryKey: true},
+			{Name: "status", Type: "text", Nullable: true, Default: &def},
+		},
+	}
+	if !schema.Success || !reflect.DeepEqual(schema.Table, wantSchema) {
+		t.Errorf("DescribeTable = %+v, want %+v", schema.Table, wantSchema)
+	}
+
+	// An empty schema is left out rather than sent as ""
+	wantParams := []map[string]interface{}{
+		{"name": "db"},
+		{"name": "db", "schema": "sales", "table": "orders"},
+	}
+	if !reflect.DeepEqual(methods, []string{"datasource.list_tables", "datasource.describe_table"}) ||
+		!reflect.DeepEqual(params, wantParams) {
+		t.Errorf("calls %q with params %v, want %v", methods, params, wantParams)
+	}
+}
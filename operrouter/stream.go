@@ -0,0 +1,107 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"sync"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// LLMStreamChunk is one piece of a streamed generation
+type LLMStreamChunk struct {
+	Text string
+	Done bool
+}
+
+// LLMStream delivers generated text incrementally.
+// Call Recv until it returns io.EOF, or range over Chunks. Close releases the
+// stream early; cancelling the context passed to StreamLLM does the same.
+type LLMStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	recv   func() (*pb.StreamLLMResponse, error)
+
+	mu       sync.Mutex
+	finished bool
+}
+
+// newLLMStream wraps a backend receive function; cancel must release every
+// resource held by the backend stream
+func newLLMStream(ctx context.Context, cancel context.CancelFunc, recv func() (*pb.StreamLLMResponse, error)) *LLMStream {
+	return &LLMStream{ctx: ctx, cancel: cancel, recv: recv}
+}
+
+// Recv returns the next chunk, or io.EOF once the stream is complete
+func (s *LLMStream) Recv() (*LLMStreamChunk, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.finished {
+		return nil, io.EOF
+	}
+
+	resp, err := s.recv()
+	if err != nil {
+		// Read ctx before finish cancels it
+		ctxErr := s.ctx.Err()
+		s.finish()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if ctxErr != nil && !errors.Is(err, ctxErr) {
+			return nil, fmt.Errorf("stream llm failed: %w: %v", ctxErr, err)
+		}
+		return nil, fmt.Errorf("stream llm failed: %w", err)
+	}
+
+	if !resp.Success && resp.Error != "" {
+		s.finish()
+		return nil, fmt.Errorf("stream llm failed: %s", resp.Error)
+	}
+	if resp.Done {
+		s.finish()
+	}
+
+	return &LLMStreamChunk{
+		Text: resp.Chunk,
+		Done: resp.Done,
+	}, nil
+}
+
+// Chunks iterates over the remaining chunks and closes the stream when the
+// loop ends, including on early break
+func (s *LLMStream) Chunks() iter.Seq2[*LLMStreamChunk, error] {
+	return func(yield func(*LLMStreamChunk, error) bool) {
+		defer s.Close()
+		for {
+			chunk, err := s.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(chunk, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Close stops the stream and releases its resources; Recv then returns io.EOF
+func (s *LLMStream) Close() error {
+	// Cancel first: it unblocks a Recv waiting on the backend
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finish()
+	return nil
+}
+
+// finish marks the stream complete and releases the backend; s.mu must be held
+func (s *LLMStream) finish() {
+	s.finished = true
+	s.cancel()
+}
@@ -0,0 +1,53 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+func TestLLMStreamClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	s := newLLMStream(ctx, cancel, func() (*pb.StreamLLMResponse, error) {
+		calls++
+		if calls == 1 {
+			return &pb.StreamLLMResponse{Success: true, Chunk: "a"}, nil
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	if chunk, err := s.Recv(); err != nil || chunk.Text != "a" {
+		t.Fatalf("Recv = %+v, %v, want chunk a", chunk, err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := s.Recv(); !errors.Is(err, io.EOF) {
+			t.Errorf("Recv after Close = %v, want io.EOF", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("backend read %d times, want no read after Close", calls)
+	}
+}
+
+func TestLLMStreamBackendError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := newLLMStream(ctx, cancel, func() (*pb.StreamLLMResponse, error) {
+		return nil, io.ErrUnexpectedEOF
+	})
+
+	_, err := s.Recv()
+	if !errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.Canceled) {
+		t.Errorf("Recv = %v, want io.ErrUnexpectedEOF without context.Canceled", err)
+	}
+	if _, err := s.Recv(); !errors.Is(err, io.EOF) {
+		t.Errorf("Recv after the error = %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,451 @@
+package operrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultStructuredAttempts is how many times GenerateStructured prompts the
+// model unless WithMaxAttempts is given
+const DefaultStructuredAttempts = 3
+
+// WithMaxAttempts sets how many times GenerateStructured prompts the model
+// before giving up, including the first attempt. Other calls reject it.
+func WithMaxAttempts(attempts int) LLMOption {
+	return func(o *llmOptions) {
+		o.maxAttempts = &attempts
+	}
+}
+
+// withoutMaxAttempts drops WithMaxAttempts from the options GenerateStructured
+// passes on to ChatLLM
+func withoutMaxAttempts() LLMOption {
+	return func(o *llmOptions) {
+		o.maxAttempts = nil
+	}
+}
+
+// StructuredOutputError is returned by GenerateStructured when no attempt
+// produced a valid value
+type StructuredOutputError struct {
+	Attempts int
+	// Output is the text of the last attempt
+	Output string
+	// Problems lists what was wrong with the last attempt
+	Problems []string
+}
+
+func (e *StructuredOutputError) Error() string {
+	return fmt.Sprintf("generate structured failed after %d attempts: %s", e.Attempts, strings.Join(e.Problems, "; "))
+}
+
+// GenerateStructured asks the model for a value of type T and decodes it.
+//
+// The JSON schema of T (see JSONSchemaFor) is sent as a schema-constrained
+// response format and repeated in the instructions, for providers that only
+// support JSON mode. The reply is validated against the schema; when it does
+// not match, the model is shown the problems and asked again, up to
+// WithMaxAttempts times. Other options, such as WithTemperature, apply to
+// every attempt.
+func GenerateStructured[T any](ctx context.Context, c Client, name string, prompt string, opts ...LLMOption) (T, error) {
+	var zero T
+	o := newLLMOptions(opts)
+	attempts := DefaultStructuredAttempts
+	if o.maxAttempts != nil {
+		if attempts = *o.maxAttempts; attempts < 1 {
+			return zero, fmt.Errorf("generate structured failed: max attempts must be at least 1, got %d", attempts)
+		}
+	}
+
+	t := reflect.TypeFor[T]()
+	root, wrapped, err := rootSchema(t)
+	if err != nil {
+		return zero, fmt.Errorf("generate structured failed: %w", err)
+	}
+	schemaJSON, err := json.Marshal(root)
+	if err != nil {
+		return zero, fmt.Errorf("generate structured failed: %w", err)
+	}
+
+	messages := []ChatMessage{
+		SystemMessage("Reply with only a JSON value, without any other text, that matches this JSON schema:\n" + string(schemaJSON)),
+		UserMessage(prompt),
+	}
+	callOpts := append(opts[:len(opts):len(opts)], WithJSONSchema(schemaName(t), schemaJSON), withoutMaxAttempts())
+
+	var lastOutput string
+	var problems []string
+	for attempt := 1; attempt <= attempts; attempt++ {
+		resp, err := c.ChatLLM(ctx, name, messages, callOpts...)
+		if err != nil {
+			return zero, err
+		}
+		if !resp.Success {
+			return zero, fmt.Errorf("generate structured failed: %s", resp.Message)
+		}
+
+		lastOutput = resp.Text
+		var value T
+		if problems = decodeStructured(resp.Text, root, wrapped, &value); len(problems) == 0 {
+			return value, nil
+		}
+		messages = append(messages,
+			AssistantMessage(resp.Text),
+			UserMessage("That reply does not match the schema:\n- "+strings.Join(problems, "\n- ")+
+				"\nReply again with only the corrected JSON."),
+		)
+	}
+	return zero, &StructuredOutputError{Attempts: attempts, Output: lastOutput, Problems: problems}
+}
+
+// JSONSchemaFor returns the JSON schema GenerateStructured derives for T.
+//
+// Struct fields are named by their json tags and described by a
+// `description:"..."` tag. Every property is required, as strict provider
+// modes demand; pointer fields and fields tagged omitempty also accept null.
+// time.Time is a date-time string and []byte a base64 string.
+func JSONSchemaFor[T any]() (json.RawMessage, error) {
+	schema, err := schemaFor(reflect.TypeFor[T](), nil)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(schema)
+}
+
+// jsonSchema is the subset of JSON schema derived from Go types
+type jsonSchema struct {
+	Type        schemaTypes            `json:"type,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Format      string                 `json:"format,omitempty"`
+	Properties  map[string]*jsonSchema `json:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	// AdditionalProperties is false for structs, a schema for maps
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema `json:"items,omitempty"`
+	Minimum              *float64    `json:"minimum,omitempty"`
+}
+
+// schemaTypes marshals as a single type name, or a list when null is allowed
+type schemaTypes []string
+
+func (t schemaTypes) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// has reports whether name is one of the types
+func (t schemaTypes) has(name string) bool {
+	for _, s := range t {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	rawMessageType      = reflect.TypeFor[json.RawMessage]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// rootSchema derives the schema sent to the provider. Providers require an
+// object at the root, so other types are wrapped in {"value": ...}.
+func rootSchema(t reflect.Type) (*jsonSchema, bool, error) {
+	schema, err := schemaFor(t, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(schema.Type) == 1 && schema.Type[0] == "object" && schema.Properties != nil {
+		return schema, false, nil
+	}
+	return &jsonSchema{
+		Type:                 schemaTypes{"object"},
+		Properties:           map[string]*jsonSchema{"value": schema},
+		Required:             []string{"value"},
+		AdditionalProperties: false,
+	}, true, nil
+}
+
+// schemaFor derives the schema of t; seen holds the structs being derived,
+// to reject recursive types
+func schemaFor(t reflect.Type, seen []reflect.Type) (*jsonSchema, error) {
+	switch {
+	case t == timeType:
+		return &jsonSchema{Type: schemaTypes{"string"}, Format: "date-time"}, nil
+	case t == rawMessageType:
+		return &jsonSchema{}, nil
+	case t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface &&
+		(t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType)):
+		// Custom JSON decoding: accept anything
+		return &jsonSchema{}, nil
+	case t.Kind() != reflect.Pointer &&
+		(t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType)):
+		return &jsonSchema{Type: schemaTypes{"string"}}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema, err := schemaFor(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return nullable(schema), nil
+	case reflect.Interface:
+		return &jsonSchema{}, nil
+	case reflect.Bool:
+		return &jsonSchema{Type: schemaTypes{"boolean"}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &jsonSchema{Type: schemaTypes{"integer"}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		zero := 0.0
+		return &jsonSchema{Type: schemaTypes{"integer"}, Minimum: &zero}, nil
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: schemaTypes{"number"}}, nil
+	case reflect.String:
+		return &jsonSchema{Type: schemaTypes{"string"}}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return &jsonSchema{Type: schemaTypes{"string"}, Description: "base64"}, nil
+		}
+		items, err := schemaFor(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonSchema{Type: schemaTypes{"array"}, Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map key type %s is not a string", t.Key())
+		}
+		values, err := schemaFor(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonSchema{Type: schemaTypes{"object"}, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		for _, s := range seen {
+			if s == t {
+				return nil, fmt.Errorf("recursive type %s has no finite schema", t)
+			}
+		}
+		schema := &jsonSchema{
+			Type:                 schemaTypes{"object"},
+			Properties:           make(map[string]*jsonSchema),
+			AdditionalProperties: false,
+		}
+		if err := addStructFields(schema, t, append(seen, t)); err != nil {
+			return nil, err
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("type %s has no JSON schema", t)
+	}
+}
+
+// addStructFields adds the JSON fields of t, flattening embedded structs the
+// way encoding/json does
+func addStructFields(schema *jsonSchema, t reflect.Type, seen []reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, flags, _ := strings.Cut(tag, ",")
+
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := addStructFields(schema, ft, seen); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if _, dup := schema.Properties[name]; dup {
+			continue
+		}
+
+		field, err := schemaFor(f.Type, seen)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		if strings.Contains(flags, "omitempty") || strings.Contains(flags, "omitzero") {
+			field = nullable(field)
+		}
+		if desc := f.Tag.Get("description"); desc != "" {
+			field.Description = desc
+		}
+		schema.Properties[name] = field
+		schema.Required = append(schema.Required, name)
+	}
+	return nil
+}
+
+// nullable lets schema accept null as well
+func nullable(schema *jsonSchema) *jsonSchema {
+	if len(schema.Type) == 0 || schema.Type.has("null") {
+		return schema
+	}
+	copied := *schema
+	copied.Type = append(schemaTypes{}, schema.Type...)
+	copied.Type = append(copied.Type, "null")
+	return &copied
+}
+
+// schemaNameChars are the characters providers accept in a schema name
+var schemaNameChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// schemaName names the schema after T
+func schemaName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if name := schemaNameChars.ReplaceAllString(t.Name(), "_"); name != "" {
+		return name
+	}
+	return "response"
+}
+
+// decodeStructured validates text against schema and decodes it into dest,
+// returning the problems found
+func decodeStructured(text string, schema *jsonSchema, wrapped bool, dest interface{}) []string {
+	data := []byte(stripCodeFence(text))
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return []string{"reply is not valid JSON: " + err.Error()}
+	}
+	if dec.More() {
+		return []string{"reply has text after the JSON value"}
+	}
+
+	var problems []string
+	validateSchema(schema, value, "$", &problems)
+	if len(problems) > 0 {
+		return problems
+	}
+
+	if wrapped {
+		data, _ = json.Marshal(value.(map[string]interface{})["value"])
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
+// stripCodeFence removes a Markdown code fence around the reply, which models
+// without a constrained JSON mode often add
+func stripCodeFence(text string) string {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "```") {
+		return text
+	}
+	text = strings.TrimPrefix(text, "```")
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		// Drop the language tag, e.g. "json"
+		text = text[i+1:]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), "```"))
+}
+
+// validateSchema appends to problems every way value breaks schema
+func validateSchema(schema *jsonSchema, value interface{}, path string, problems *[]string) {
+	if len(schema.Type) == 0 {
+		return
+	}
+
+	var got string
+	switch v := value.(type) {
+	case nil:
+		got = "null"
+	case bool:
+		got = "boolean"
+	case string:
+		got = "string"
+	case json.Number:
+		got = "number"
+		if !strings.ContainsAny(v.String(), ".eE") {
+			got = "integer"
+		} else if f, err := v.Float64(); err == nil && f == float64(int64(f)) && schema.Type.has("integer") {
+			got = "integer"
+		}
+	case []interface{}:
+		got = "array"
+	case map[string]interface{}:
+		got = "object"
+	}
+	if !schema.Type.has(got) && !(got == "integer" && schema.Type.has("number")) {
+		*problems = append(*problems, fmt.Sprintf("%s: expected %s, got %s", path, strings.Join(schema.Type, " or "), got))
+		return
+	}
+
+	switch v := value.(type) {
+	case string:
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				*problems = append(*problems, fmt.Sprintf("%s: expected an RFC 3339 date-time, got %q", path, v))
+			}
+		}
+	case json.Number:
+		if schema.Minimum != nil {
+			if f, _ := v.Float64(); f < *schema.Minimum {
+				*problems = append(*problems, fmt.Sprintf("%s: must be at least %v", path, *schema.Minimum))
+			}
+		}
+	case []interface{}:
+		if schema.Items != nil {
+			for i, item := range v {
+				validateSchema(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), problems)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, ok := v[name]; !ok {
+				*problems = append(*problems, fmt.Sprintf("%s: missing property %q", path, name))
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if prop, ok := schema.Properties[key]; ok {
+				validateSchema(prop, v[key], path+"."+key, problems)
+				continue
+			}
+			switch extra := schema.AdditionalProperties.(type) {
+			case bool:
+				if !extra {
+					*problems = append(*problems, fmt.Sprintf("%s: unknown property %q", path, key))
+				}
+			case *jsonSchema:
+				validateSchema(extra, v[key], path+"."+key, problems)
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package operrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type schemaAddress struct {
+	City string `json:"city" description:"City name"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type schemaPerson struct {
+	schemaAddress
+	Name     string            `json:"name"`
+	Age      uint8             `json:"age"`
+	Email    *string           `json:"email"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]int    `json:"labels"`
+	Born     time.Time         `json:"born"`
+	Photo    []byte            `json:"photo"`
+	Extra    json.RawMessage   `json:"extra"`
+	Internal string            `json:"-"`
+	private  string            // unexported fields are skipped
+	Scores   [2]float64        `json:"scores"`
+	Nested   *schemaAddress    `json:"nested"`
+	Any      interface{}       `json:"any"`
+	Plain    string            // named after the field
+	Meta     map[string]string `json:"meta,omitempty"`
+}
+
+type schemaNode struct {
+	Children []schemaNode `json:"children"`
+}
+
+func schemaJSON[T any](t *testing.T) string {
+	t.Helper()
+	schema, err := JSONSchemaFor[T]()
+	if err != nil {
+		t.Fatalf("JSONSchemaFor: %v", err)
+	}
+	return string(schema)
+}
+
+func TestJSONSchemaFor(t *testing.T) {
+	tests := []struct {
+		name string
+		got  func(t *testing.T) string
+		want string
+	}{
+		{"string", schemaJSON[string], `{"type":"string"}`},
+		{"unsigned", schemaJSON[uint], `{"type":"integer","minimum":0}`},
+		{"pointer", schemaJSON[*float64], `{"type":["number","null"]}`},
+		{"bytes", schemaJSON[[]byte], `{"type":"string","description":"base64"}`},
+		{"time", schemaJSON[time.Time], `{"type":"string","format":"date-time"}`},
+		{"slice", schemaJSON[[]bool], `{"type":"array","items":{"type":"boolean"}}`},
+		{"map", schemaJSON[map[string]int], `{"type":"object","additionalProperties":{"type":"integer"}}`},
+		{
+			"struct",
+			schemaJSON[schemaAddress],
+			`{"type":"object","properties":{"city":{"type":"string","description":"City name"},"zip":{"type":["string","null"]}},"required":["city","zip"],"additionalProperties":false}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got(t); got != tt.want {
+				t.Errorf("JSONSchemaFor = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaForStruct(t *testing.T) {
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+	}
+	if err := json.Unmarshal([]byte(schemaJSON[schemaPerson](t)), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	wantRequired := []string{"city", "zip", "name", "age", "email", "tags", "labels", "born", "photo", "extra", "scores", "nested", "any", "Plain", "meta"}
+	if !reflect.DeepEqual(schema.Required, wantRequired) {
+		t.Errorf("required = %q, want %q", schema.Required, wantRequired)
+	}
+	for name, want := range map[string]string{
+		"email":  `{"type":["string","null"]}`,
+		"extra":  `{}`,
+		"any":    `{}`,
+		"scores": `{"type":"array","items":{"type":"number"}}`,
+		"meta":   `{"type":["object","null"],"additionalProperties":{"type":"string"}}`,
+	} {
+		if got := string(schema.Properties[name]); got != want {
+			t.Errorf("property %s = %s, want %s", name, got, want)
+		}
+	}
+	if !bytes.Contains(schema.Properties["nested"], []byte(`"type":["object","null"]`)) {
+		t.Errorf("property nested = %s, want a nullable object", schema.Properties["nested"])
+	}
+}
+
+func TestJSONSchemaForErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  func() error
+		want string
+	}{
+		{"recursive", func() error { _, err := JSONSchemaFor[schemaNode](); return err }, "recursive type"},
+		{"int map key", func() error { _, err := JSONSchemaFor[map[int]string](); return err }, "not a string"},
+		{"channel", func() error { _, err := JSONSchemaFor[struct{ C chan int }](); return err }, "field C: type chan int has no JSON schema"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.err(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	root, _, err := rootSchema(reflect.TypeFor[schemaPerson]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := `{"city":"Oslo","zip":null,"name":"Ann","age":30,"email":null,"tags":["a"],"labels":{"x":1},` +
+		`"born":"2000-01-02T03:04:05Z","photo":"AA==","extra":{"any":[1]},"scores":[1,2.5],"nested":{"city":"Bergen","zip":"5003"},` +
+		`"any":"x","Plain":"p","meta":{"k":"v"}}`
+
+	tests := []struct {
+		name   string
+		change map[string]interface{}
+		want   []string
+	}{
+		{"valid", nil, nil},
+		{"whole float as integer", map[string]interface{}{"age": json.Number("30.0")}, nil},
+		{"integer as number", map[string]interface{}{"scores": []interface{}{json.Number("1"), json.Number("2")}}, nil},
+		{"wrong type", map[string]interface{}{"name": json.Number("1")}, []string{"$.name: expected string, got integer"}},
+		{"fraction as integer", map[string]interface{}{"age": json.Number("1.5")}, []string{"$.age: expected integer, got number"}},
+		{"below minimum", map[string]interface{}{"age": json.Number("-1")}, []string{"$.age: must be at least 0"}},
+		{"null not allowed", map[string]interface{}{"name": nil}, []string{"$.name: expected string, got null"}},
+		{"bad date", map[string]interface{}{"born": "yesterday"}, []string{`$.born: expected an RFC 3339 date-time, got "yesterday"`}},
+		{"array item", map[string]interface{}{"tags": []interface{}{"a", true}}, []string{"$.tags[1]: expected string, got boolean"}},
+		{"map value", map[string]interface{}{"labels": map[string]interface{}{"x": "one"}}, []string{"$.labels.x: expected integer, got string"}},
+		{"nested missing", map[string]interface{}{"nested": map[string]interface{}{"zip": nil}}, []string{`$.nested: missing property "city"`}},
+		{"unknown property", map[string]interface{}{"nickname": "A"}, []string{`$: unknown property "nickname"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(valid))
+			dec.UseNumber()
+			var value map[string]interface{}
+			if err := dec.Decode(&value); err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range tt.change {
+				value[k] = v
+			}
+
+			var problems []string
+			validateSchema(root, value, "$", &problems)
+			if !reflect.DeepEqual(problems, tt.want) {
+				t.Errorf("problems = %q, want %q", problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripCodeFence(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`{"a":1}`, `{"a":1}`},
+		{"  {\"a\":1}\n", `{"a":1}`},
+		{"```json\n{\"a\":1}\n```", `{"a":1}`},
+		{"```\n[1, 2]\n```\n", `[1, 2]`},
+		{"```JSON\r\n{}\r\n```", `{}`},
+		{"```json\n{\"a\":1}", `{"a":1}`},
+		{"```", ""},
+	}
+
+	for _, tt := range tests {
+		if got := stripCodeFence(tt.in); got != tt.want {
+			t.Errorf("stripCodeFence(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// chatClient answers ChatLLM with canned replies; other Client methods panic
+type chatClient struct {
+	Client
+	replies  []string
+	requests [][]ChatMessage
+}
+
+func (c *chatClient) ChatLLM(ctx context.Context, name string, messages []ChatMessage, opts ...LLMOption) (*LLMGenerateResponse, error) {
+	if newLLMOptions(opts).maxAttempts != nil {
+		return nil, errors.New("max attempts passed on to ChatLLM")
+	}
+	c.requests = append(c.requests, messages)
+	reply := c.replies[0]
+	c.replies = c.replies[1:]
+	return &LLMGenerateResponse{Success: true, Text: reply}, nil
+}
+
+func TestGenerateStructured(t *testing.T) {
+	c := &chatClient{replies: []string{
+		`{"city": 5}`,
+		"```json\n{\"city\": \"Oslo\", \"zip\": null}\n```",
+	}}
+	got, err := GenerateStructured[schemaAddress](context.Background(), c, "llm", "Where?", WithMaxAttempts(2))
+	if err != nil {
+		t.Fatalf("GenerateStructured: %v", err)
+	}
+	if got != (schemaAddress{City: "Oslo"}) {
+		t.Errorf("GenerateStructured = %+v, want city Oslo", got)
+	}
+	if len(c.requests) != 2 || len(c.requests[1]) != 4 ||
+		!strings.Contains(c.requests[1][3].Content, `$.city: expected string, got integer`) {
+		t.Errorf("retry messages = %+v, want the problems of the first reply", c.requests[len(c.requests)-1])
+	}
+
+	c = &chatClient{replies: []string{`{"value": [1, 2]}`}}
+	list, err := GenerateStructured[[]int](context.Background(), c, "llm", "Count")
+	if err != nil || !reflect.DeepEqual(list, []int{1, 2}) {
+		t.Errorf("GenerateStructured[[]int] = %v, %v, want [1 2]", list, err)
+	}
+
+	c = &chatClient{replies: []string{"no", "still no"}}
+	_, err = GenerateStructured[schemaAddress](context.Background(), c, "llm", "Where?", WithMaxAttempts(2))
+	var structErr *StructuredOutputError
+	if !errors.As(err, &structErr) || structErr.Attempts != 2 || structErr.Output != "still no" {
+		t.Errorf("GenerateStructured error = %v, want a StructuredOutputError after 2 attempts", err)
+	}
+
+	if _, err := GenerateStructured[schemaAddress](context.Background(), c, "llm", "Where?", WithMaxAttempts(0)); err == nil {
+		t.Error("GenerateStructured accepted 0 attempts")
+	}
+}
@@ -0,0 +1,131 @@
+package operrouter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// Tool choices understood by WithToolChoice besides a tool name
+const (
+	ToolChoiceAuto     = "auto"
+	ToolChoiceNone     = "none"
+	ToolChoiceRequired = "required"
+)
+
+// Tool declares a function the model may call
+type Tool struct {
+	Name        string
+	Description string
+	// Parameters is the JSON schema of the arguments object, e.g.
+	// {"type": "object", "properties": {"city": {"type": "string"}}}.
+	// Nil declares a tool without arguments.
+	Parameters json.RawMessage
+}
+
+// ToolCall is a call the model asks the caller to run. Answer it with a
+// ToolMessage carrying the same ID.
+type ToolCall struct {
+	ID   string
+	Name string
+	// Arguments is the JSON object of arguments chosen by the model
+	Arguments json.RawMessage
+}
+
+// DecodeArguments unmarshals the arguments into v
+func (tc ToolCall) DecodeArguments(v interface{}) error {
+	if err := json.Unmarshal(tc.Arguments, v); err != nil {
+		return fmt.Errorf("tool call %s (%s): invalid arguments: %w", tc.ID, tc.Name, err)
+	}
+	return nil
+}
+
+// ToolMessage returns the result of a tool call, to send back in the next
+// ChatLLM call after the assistant message that requested it
+func ToolMessage(call ToolCall, content string) ChatMessage {
+	return ChatMessage{Role: RoleTool, Name: call.Name, ToolCallID: call.ID, Content: content}
+}
+
+// toolsToProto validates and converts tool declarations
+func toolsToProto(tools []Tool) ([]*pb.ToolDefinition, error) {
+	seen := make(map[string]bool, len(tools))
+	protoTools := make([]*pb.ToolDefinition, len(tools))
+	for i, tool := range tools {
+		if tool.Name == "" {
+			return nil, fmt.Errorf("tool %d has no name", i)
+		}
+		if seen[tool.Name] {
+			return nil, fmt.Errorf("tool %s is declared twice", tool.Name)
+		}
+		seen[tool.Name] = true
+		if len(tool.Parameters) > 0 && !json.Valid(tool.Parameters) {
+			return nil, fmt.Errorf("tool %s: parameters are not valid JSON", tool.Name)
+		}
+		protoTools[i] = &pb.ToolDefinition{
+			Name:           tool.Name,
+			Description:    tool.Description,
+			ParametersJson: string(tool.Parameters),
+		}
+	}
+	return protoTools, nil
+}
+
+// checkToolChoice accepts the ToolChoice constants and the names of tools
+func checkToolChoice(choice string, tools []Tool) error {
+	switch choice {
+	case "", ToolChoiceAuto, ToolChoiceNone:
+		return nil
+	case ToolChoiceRequired:
+		if len(tools) == 0 {
+			return fmt.Errorf("tool choice %q needs at least one tool", choice)
+		}
+		return nil
+	}
+	for _, tool := range tools {
+		if tool.Name == choice {
+			return nil
+		}
+	}
+	return fmt.Errorf("tool choice %q is not a declared tool", choice)
+}
+
+// toolCallsToProto converts the calls of an assistant message
+func toolCallsToProto(calls []ToolCall) ([]*pb.ToolCall, error) {
+	protoCalls := make([]*pb.ToolCall, len(calls))
+	for i, call := range calls {
+		if call.ID == "" || call.Name == "" {
+			return nil, fmt.Errorf("tool call %d needs an ID and a name", i)
+		}
+		protoCalls[i] = &pb.ToolCall{
+			Id:            call.ID,
+			Name:          call.Name,
+			ArgumentsJson: string(call.Arguments),
+		}
+	}
+	return protoCalls, nil
+}
+
+// toolCallsFromProto converts the calls of a response. Providers that do not
+// assign call IDs (such as Ollama) get positional ones, so tool messages can
+// always refer to their call.
+func toolCallsFromProto(protoCalls []*pb.ToolCall) []ToolCall {
+	if len(protoCalls) == 0 {
+		return nil
+	}
+	calls := make([]ToolCall, len(protoCalls))
+	for i, c := range protoCalls {
+		calls[i] = ToolCall{
+			ID:        c.Id,
+			Name:      c.Name,
+			Arguments: json.RawMessage(c.ArgumentsJson),
+		}
+		if calls[i].ID == "" {
+			calls[i].ID = fmt.Sprintf("call_%d", i)
+		}
+		if len(calls[i].Arguments) == 0 {
+			calls[i].Arguments = json.RawMessage("{}")
+		}
+	}
+	return calls
+}
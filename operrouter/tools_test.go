@@ -0,0 +1,136 @@
+package operrouter
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+var weatherTool = Tool{
+	Name:        "weather",
+	Description: "Current weather in a city",
+	Parameters:  json.RawMessage(`{"type":"object","properties":{"city":{"type":"string"}}}`),
+}
+
+func TestChatRequestTools(t *testing.T) {
+	call := ToolCall{ID: "call_1", Name: "weather", Arguments: json.RawMessage(`{"city":"Oslo"}`)}
+	req, err := chatRequest("gpt", []ChatMessage{
+		UserMessage("weather in Oslo?"),
+		{Role: RoleAssistant, ToolCalls: []ToolCall{call}},
+		ToolMessage(call, "sunny"),
+	}, []LLMOption{WithTools(weatherTool, Tool{Name: "now"}), WithToolChoice("weather")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantParams := map[string]interface{}{
+		"name": "gpt",
+		"messages": []map[string]interface{}{
+			{"role": RoleUser, "content": "weather in Oslo?"},
+			{"role": RoleAssistant, "content": "", "tool_calls": []map[string]interface{}{
+				{"id": "call_1", "name": "weather", "arguments": `{"city":"Oslo"}`},
+			}},
+			{"role": RoleTool, "content": "sunny", "name": "weather", "tool_call_id": "call_1"},
+		},
+		"tools": []map[string]interface{}{
+			{"name": "weather", "description": "Current weather in a city", "parameters": weatherTool.Parameters},
+			{"name": "now", "description": ""},
+		},
+		"tool_choice": "weather",
+	}
+	if got := chatParams(req); !reflect.DeepEqual(got, wantParams) {
+		t.Errorf("chatParams = %v, want %v", got, wantParams)
+	}
+}
+
+func TestChatRequestToolErrors(t *testing.T) {
+	user := []ChatMessage{UserMessage("hi")}
+
+	tests := []struct {
+		name     string
+		messages []ChatMessage
+		opts     []LLMOption
+		err      string
+	}{
+		{"tool without name", user, []LLMOption{WithTools(Tool{Description: "x"})}, "tool 0 has no name"},
+		{"tool declared twice", user, []LLMOption{WithTools(weatherTool), WithTools(weatherTool)}, "tool weather is declared twice"},
+		{
+			"invalid parameters",
+			user,
+			[]LLMOption{WithTools(Tool{Name: "now", Parameters: json.RawMessage(`{"type":`)})},
+			"tool now: parameters are not valid JSON",
+		},
+		{"required without tools", user, []LLMOption{WithToolChoice(ToolChoiceRequired)}, `tool choice "required" needs at least one tool`},
+		{"choice of an undeclared tool", user, []LLMOption{WithTools(weatherTool), WithToolChoice("clock")}, `tool choice "clock" is not a declared tool`},
+		{
+			"tool calls on a user message",
+			[]ChatMessage{{Role: RoleUser, ToolCalls: []ToolCall{{ID: "call_1", Name: "weather"}}}},
+			nil,
+			"message 0: only assistant messages carry tool calls",
+		},
+		{
+			"tool call without ID",
+			[]ChatMessage{{Role: RoleAssistant, ToolCalls: []ToolCall{{Name: "weather"}}}},
+			nil,
+			"message 0: tool call 0 needs an ID and a name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chatRequest("gpt", tt.messages, tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestToolsOnlyForChat(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []LLMOption
+	}{
+		{"tools", []LLMOption{WithTools(weatherTool)}},
+		{"tool choice", []LLMOption{WithToolChoice(ToolChoiceNone)}},
+	}
+
+	for _, tt := range tests {
+		if _, err := generateRequest("gpt", "hi", tt.opts); err == nil || !strings.Contains(err.Error(), "only supported by ChatLLM") {
+			t.Errorf("generateRequest with %s: error = %v", tt.name, err)
+		}
+		if _, err := streamRequest("gpt", "hi", tt.opts); err == nil || !strings.Contains(err.Error(), "only supported by ChatLLM") {
+			t.Errorf("streamRequest with %s: error = %v", tt.name, err)
+		}
+	}
+}
+
+func TestToolCallsFromProto(t *testing.T) {
+	got := toolCallsFromProto([]*pb.ToolCall{
+		{Id: "call_abc", Name: "weather", ArgumentsJson: `{"city":"Oslo"}`},
+		// Ollama assigns no IDs and may omit arguments
+		{Name: "now"},
+	})
+	want := []ToolCall{
+		{ID: "call_abc", Name: "weather", Arguments: json.RawMessage(`{"city":"Oslo"}`)},
+		{ID: "call_1", Name: "now", Arguments: json.RawMessage(`{}`)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toolCallsFromProto = %+v, want %+v", got, want)
+	}
+	if got := toolCallsFromProto(nil); got != nil {
+		t.Errorf("toolCallsFromProto(nil) = %+v, want nil", got)
+	}
+
+	var args struct{ City string }
+	if err := got[0].DecodeArguments(&args); err != nil || args.City != "Oslo" {
+		t.Errorf("DecodeArguments = %+v, %v", args, err)
+	}
+	bad := ToolCall{ID: "call_2", Name: "weather", Arguments: json.RawMessage(`{"city":`)}
+	if err := bad.DecodeArguments(&args); err == nil || !strings.Contains(err.Error(), "tool call call_2 (weather): invalid arguments") {
+		t.Errorf("DecodeArguments of truncated JSON: error = %v", err)
+	}
+}
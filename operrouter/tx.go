@@ -0,0 +1,163 @@
+package operrouter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// ErrTxDone is returned by operations on a transaction that has already been
+// committed or rolled back
+var ErrTxDone = errors.New("operrouter: transaction has already been committed or rolled back")
+
+// txBackend is implemented by every client to run statements inside a
+// server-side transaction
+type txBackend interface {
+	query(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceQueryResponse, error)
+	execute(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceExecuteResponse, error)
+	insertRows(ctx context.Context, name, txID, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error)
+	endTx(ctx context.Context, name, txID string, commit bool) error
+}
+
+// Tx is a transaction on a DataSource, started with BeginTx.
+// It must end with Commit or Rollback. If the context passed to BeginTx is
+// cancelled first, the transaction is rolled back.
+type Tx struct {
+	ctx     context.Context
+	backend txBackend
+	logger  *slog.Logger
+	name    string
+	id      string
+
+	mu   sync.Mutex
+	done bool
+	stop func() bool // guarded by mu
+}
+
+// newTx wraps transaction id and arranges a rollback when ctx ends
+func newTx(ctx context.Context, backend txBackend, logger *slog.Logger, name, id string) *Tx {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tx := &Tx{ctx: ctx, backend: backend, logger: logger, name: name, id: id}
+	// Hold mu so that a ctx that is already done cannot reach end before
+	// stop is set
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.stop = context.AfterFunc(ctx, func() {
+		if err := tx.end(context.WithoutCancel(ctx), false); err != nil && !errors.Is(err, ErrTxDone) {
+			tx.logger.WarnContext(ctx, "operrouter transaction rollback failed",
+				"datasource", name, "transaction", id, "error", err)
+		}
+	})
+	return tx
+}
+
+// ID returns the server-side transaction id
+func (tx *Tx) ID() string {
+	return tx.id
+}
+
+// Query executes a read query inside the transaction
+func (tx *Tx) Query(ctx context.Context, query string, args ...interface{}) (*DataSourceQueryResponse, error) {
+	if err := tx.check(); err != nil {
+		return nil, err
+	}
+	return tx.backend.query(ctx, tx.name, tx.id, query, args)
+}
+
+// Execute executes a write operation inside the transaction
+func (tx *Tx) Execute(ctx context.Context, query string, args ...interface{}) (*DataSourceExecuteResponse, error) {
+	if err := tx.check(); err != nil {
+		return nil, err
+	}
+	return tx.backend.execute(ctx, tx.name, tx.id, query, args)
+}
+
+// Insert inserts rows into a table or collection inside the transaction
+func (tx *Tx) Insert(ctx context.Context, table string, rows []map[string]interface{}, opts ...InsertOption) (*DataSourceInsertResponse, error) {
+	if err := tx.check(); err != nil {
+		return nil, err
+	}
+	return bulkInsert(ctx, table, rows, opts, func(ctx context.Context, table string, batch []map[string]interface{}) (*DataSourceInsertResponse, error) {
+		return tx.backend.insertRows(ctx, tx.name, tx.id, table, batch)
+	})
+}
+
+// Commit commits the transaction
+func (tx *Tx) Commit() error {
+	if err := tx.ctx.Err(); err != nil {
+		// The rollback started by the cancellation wins
+		return err
+	}
+	return tx.end(tx.ctx, true)
+}
+
+// Rollback aborts the transaction
+func (tx *Tx) Rollback() error {
+	return tx.end(context.WithoutCancel(tx.ctx), false)
+}
+
+// check reports whether the transaction can still be used
+func (tx *Tx) check() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return ErrTxDone
+	}
+	return tx.ctx.Err()
+}
+
+// end commits or rolls back the transaction once
+func (tx *Tx) end(ctx context.Context, commit bool) error {
+	tx.mu.Lock()
+	if tx.done {
+		tx.mu.Unlock()
+		return ErrTxDone
+	}
+	tx.done = true
+	stop := tx.stop
+	tx.mu.Unlock()
+
+	stop()
+	return tx.backend.endTx(ctx, tx.name, tx.id, commit)
+}
+
+// isolationLevel maps sql.TxOptions onto the proto isolation level
+func isolationLevel(opts *sql.TxOptions) (pb.IsolationLevel, bool, error) {
+	if opts == nil {
+		return pb.IsolationLevel_ISOLATION_LEVEL_UNSPECIFIED, false, nil
+	}
+	var level pb.IsolationLevel
+	switch opts.Isolation {
+	case sql.LevelDefault:
+		level = pb.IsolationLevel_ISOLATION_LEVEL_UNSPECIFIED
+	case sql.LevelReadUncommitted:
+		level = pb.IsolationLevel_ISOLATION_LEVEL_READ_UNCOMMITTED
+	case sql.LevelReadCommitted:
+		level = pb.IsolationLevel_ISOLATION_LEVEL_READ_COMMITTED
+	case sql.LevelRepeatableRead:
+		level = pb.IsolationLevel_ISOLATION_LEVEL_REPEATABLE_READ
+	case sql.LevelSerializable:
+		level = pb.IsolationLevel_ISOLATION_LEVEL_SERIALIZABLE
+	default:
+		return 0, false, fmt.Errorf("begin transaction failed: unsupported isolation level %s", opts.Isolation)
+	}
+	return level, opts.ReadOnly, nil
+}
+
+// txEndError turns an unsuccessful commit or rollback reply into an error
+func txEndError(commit, success bool, message string) error {
+	if success {
+		return nil
+	}
+	if commit {
+		return fmt.Errorf("commit transaction failed: %s", message)
+	}
+	return fmt.Errorf("rollback transaction failed: %s", message)
+}
@@ -0,0 +1,180 @@
+package operrouter
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// txRecorder is a txBackend that records how transactions end. endTx blocks
+// until release is closed when release is set.
+type txRecorder struct {
+	mu      sync.Mutex
+	ends    []string
+	ended   chan struct{}
+	release chan struct{}
+	execs   int
+}
+
+func newTxRecorder() *txRecorder {
+	return &txRecorder{ended: make(chan struct{}, 2)}
+}
+
+func (b *txRecorder) query(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceQueryResponse, error) {
+	return &DataSourceQueryResponse{Success: true}, nil
+}
+
+func (b *txRecorder) execute(ctx context.Context, name, txID, query string, args []interface{}) (*DataSourceExecuteResponse, error) {
+	b.mu.Lock()
+	b.execs++
+	b.mu.Unlock()
+	return &DataSourceExecuteResponse{Success: true}, nil
+}
+
+func (b *txRecorder) insertRows(ctx context.Context, name, txID, table string, rows []map[string]interface{}) (*DataSourceInsertResponse, error) {
+	return &DataSourceInsertResponse{Success: true, InsertedRows: uint64(len(rows))}, nil
+}
+
+func (b *txRecorder) endTx(ctx context.Context, name, txID string, commit bool) error {
+	if b.release != nil {
+		<-b.release
+	}
+	end := "rollback"
+	if commit {
+		end = "commit"
+	}
+	if ctx.Err() != nil {
+		end += " with a done ctx"
+	}
+	b.mu.Lock()
+	b.ends = append(b.ends, end)
+	b.mu.Unlock()
+	b.ended <- struct{}{}
+	return nil
+}
+
+func (b *txRecorder) endings() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.ends...)
+}
+
+// waitEnd waits for the rollback that the cancelled context starts in the
+// background
+func (b *txRecorder) waitEnd(t *testing.T) {
+	t.Helper()
+	select {
+	case <-b.ended:
+	case <-time.After(5 * time.Second):
+		t.Fatal("transaction did not end")
+	}
+}
+
+func TestTxRollbackOnCancel(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration // 0 cancels the context instead
+		wantErr error
+	}{
+		{"cancel", 0, context.Canceled},
+		{"deadline", time.Millisecond, context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.timeout > 0 {
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			b := newTxRecorder()
+			tx := newTx(ctx, b, slog.New(slog.DiscardHandler), "db", "tx1")
+
+			if tt.timeout == 0 {
+				cancel()
+			}
+			b.waitEnd(t)
+			if got := b.endings(); !reflect.DeepEqual(got, []string{"rollback"}) {
+				t.Errorf("endings = %q, want a rollback on a live ctx", got)
+			}
+
+			// Commit after ctx is done reports why instead of committing
+			if err := tx.Commit(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Commit = %v, want %v", err, tt.wantErr)
+			}
+			if err := tx.Rollback(); !errors.Is(err, ErrTxDone) {
+				t.Errorf("Rollback = %v, want ErrTxDone", err)
+			}
+			if _, err := tx.Execute(context.Background(), "DELETE FROM t"); !errors.Is(err, ErrTxDone) {
+				t.Errorf("Execute = %v, want ErrTxDone", err)
+			}
+			if got := b.endings(); !reflect.DeepEqual(got, []string{"rollback"}) || b.execs != 0 {
+				t.Errorf("endings = %q and %d statements after the rollback, want nothing more", got, b.execs)
+			}
+		})
+	}
+}
+
+func TestTxCommitThenCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := newTxRecorder()
+	b.release = make(chan struct{})
+	tx := newTx(ctx, b, slog.New(slog.DiscardHandler), "db", "tx1")
+
+	if _, err := tx.Execute(ctx, "UPDATE t SET a = 1"); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	// Cancelling while the commit is in flight must not start a rollback
+	done := make(chan error, 1)
+	go func() { done <- tx.Commit() }()
+	for {
+		tx.mu.Lock()
+		started := tx.done
+		tx.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	close(b.release)
+	if err := <-done; err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	// Give a wrongly scheduled rollback the chance to run
+	time.Sleep(10 * time.Millisecond)
+	if got := b.endings(); !reflect.DeepEqual(got, []string{"commit with a done ctx"}) {
+		t.Errorf("endings = %q, want only the commit", got)
+	}
+
+	if err := tx.Commit(); !errors.Is(err, context.Canceled) {
+		t.Errorf("second Commit = %v, want context.Canceled", err)
+	}
+	if err := tx.Rollback(); !errors.Is(err, ErrTxDone) {
+		t.Errorf("Rollback after Commit = %v, want ErrTxDone", err)
+	}
+}
+
+func TestTxRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := newTxRecorder()
+	tx := newTx(ctx, b, slog.New(slog.DiscardHandler), "db", "tx1")
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	if got := b.endings(); !reflect.DeepEqual(got, []string{"rollback"}) {
+		t.Errorf("endings = %q, want a single rollback", got)
+	}
+	if err := tx.Commit(); err == nil {
+		t.Error("Commit after Rollback succeeded")
+	}
+}
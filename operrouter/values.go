@@ -0,0 +1,266 @@
+package operrouter
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+)
+
+// ValueMarshaler is implemented by types that encode themselves as a pb.Value
+type ValueMarshaler interface {
+	MarshalValue() (*pb.Value, error)
+}
+
+// ValueToGo converts a pb.Value into a native Go value: nil, bool, int64,
+// float64, string, []byte, []interface{} or map[string]interface{}.
+// Arrays and objects are converted recursively.
+func ValueToGo(v *pb.Value) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch val := v.Value.(type) {
+	case *pb.Value_NullValue:
+		return nil
+	case *pb.Value_BoolValue:
+		return val.BoolValue
+	case *pb.Value_IntValue:
+		return val.IntValue
+	case *pb.Value_FloatValue:
+		return val.FloatValue
+	case *pb.Value_StringValue:
+		return val.StringValue
+	case *pb.Value_BytesValue:
+		return val.BytesValue
+	case *pb.Value_ArrayValue:
+		return arrayToGo(val.ArrayValue)
+	case *pb.Value_ObjectValue:
+		return objectToGo(val.ObjectValue)
+	default:
+		return nil
+	}
+}
+
+// arrayToGo converts a pb.ValueArray into []interface{}
+func arrayToGo(a *pb.ValueArray) []interface{} {
+	values := make([]interface{}, len(a.GetValues()))
+	for i, v := range a.GetValues() {
+		values[i] = ValueToGo(v)
+	}
+	return values
+}
+
+// objectToGo converts a pb.ValueObject into map[string]interface{}
+func objectToGo(o *pb.ValueObject) map[string]interface{} {
+	fields := make(map[string]interface{}, len(o.GetFields()))
+	for k, v := range o.GetFields() {
+		fields[k] = ValueToGo(v)
+	}
+	return fields
+}
+
+// RowToMap converts a pb.Row into a map of native Go values
+func RowToMap(row *pb.Row) map[string]interface{} {
+	rowMap := make(map[string]interface{}, len(row.GetColumns()))
+	for key, value := range row.GetColumns() {
+		rowMap[key] = ValueToGo(value)
+	}
+	return rowMap
+}
+
+// rowsFromProto converts query result rows for DataSourceQueryResponse
+func rowsFromProto(protoRows []*pb.Row) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(protoRows))
+	for _, protoRow := range protoRows {
+		rows = append(rows, RowToMap(protoRow))
+	}
+	return rows
+}
+
+// GoToValue converts a Go value into a pb.Value.
+// It accepts nil, booleans, integers, floats, strings, []byte, json.Number,
+// time.Time (sent as an RFC 3339 string), driver.Valuer types such as
+// sql.NullString, ValueMarshaler types, and pointers, slices, arrays and
+// string-keyed maps of those. Slices become ValueArray and maps ValueObject.
+func GoToValue(v interface{}) (*pb.Value, error) {
+	return goToValue(reflect.ValueOf(v))
+}
+
+func goToValue(rv reflect.Value) (*pb.Value, error) {
+	if !rv.IsValid() {
+		return nullValue(), nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		if rv.IsNil() {
+			return nullValue(), nil
+		}
+	}
+
+	if rv.CanInterface() {
+		switch val := rv.Interface().(type) {
+		case *pb.Value:
+			return val, nil
+		case ValueMarshaler:
+			return val.MarshalValue()
+		case time.Time:
+			return &pb.Value{Value: &pb.Value_StringValue{StringValue: val.Format(time.RFC3339Nano)}}, nil
+		case json.Number:
+			if i, err := val.Int64(); err == nil {
+				return &pb.Value{Value: &pb.Value_IntValue{IntValue: i}}, nil
+			}
+			f, err := val.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q", val)
+			}
+			return &pb.Value{Value: &pb.Value_FloatValue{FloatValue: f}}, nil
+		case driver.Valuer:
+			dv, err := val.Value()
+			if err != nil {
+				return nil, err
+			}
+			return goToValue(reflect.ValueOf(dv))
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return goToValue(rv.Elem())
+	case reflect.Bool:
+		return &pb.Value{Value: &pb.Value_BoolValue{BoolValue: rv.Bool()}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &pb.Value{Value: &pb.Value_IntValue{IntValue: rv.Int()}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := rv.Uint()
+		if n > math.MaxInt64 {
+			return nil, fmt.Errorf("integer %d overflows int64", n)
+		}
+		return &pb.Value{Value: &pb.Value_IntValue{IntValue: int64(n)}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &pb.Value{Value: &pb.Value_FloatValue{FloatValue: rv.Float()}}, nil
+	case reflect.String:
+		return &pb.Value{Value: &pb.Value_StringValue{StringValue: rv.String()}}, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return &pb.Value{Value: &pb.Value_BytesValue{BytesValue: b}}, nil
+		}
+		values := make([]*pb.Value, rv.Len())
+		for i := range values {
+			elem, err := goToValue(rv.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			values[i] = elem
+		}
+		return &pb.Value{Value: &pb.Value_ArrayValue{ArrayValue: &pb.ValueArray{Values: values}}}, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %s", rv.Type().Key())
+		}
+		fields := make(map[string]*pb.Value, rv.Len())
+		entries := rv.MapRange()
+		for entries.Next() {
+			key := entries.Key().String()
+			elem, err := goToValue(entries.Value())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			fields[key] = elem
+		}
+		return &pb.Value{Value: &pb.Value_ObjectValue{ObjectValue: &pb.ValueObject{Fields: fields}}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", rv.Type())
+}
+
+// nullValue returns the pb.Value for nil
+func nullValue() *pb.Value {
+	return &pb.Value{Value: &pb.Value_NullValue{NullValue: true}}
+}
+
+// MapToRow converts a map of Go values into a pb.Row using GoToValue
+func MapToRow(data map[string]interface{}) (*pb.Row, error) {
+	columns := make(map[string]*pb.Value, len(data))
+	for key, val := range data {
+		value, err := GoToValue(val)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", key, err)
+		}
+		columns[key] = value
+	}
+	return &pb.Row{Columns: columns}, nil
+}
+
+// insertRequest builds an InsertDataSourceRequest carrying rows
+func insertRequest(name, txID, table string, rows []map[string]interface{}) (*pb.InsertDataSourceRequest, error) {
+	protoRows := make([]*pb.Row, len(rows))
+	for i, data := range rows {
+		row, err := MapToRow(data)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		protoRows[i] = row
+	}
+	return &pb.InsertDataSourceRequest{
+		Name:          name,
+		Table:         table,
+		Rows:          protoRows,
+		TransactionId: txID,
+	}, nil
+}
+
+// normalizeRow encodes a row the way the proto backends do and decodes it back,
+// so JSON-RPC requests carry the same values: sql.Null* types are unwrapped,
+// times become RFC 3339 strings and ValueMarshaler types are applied
+func normalizeRow(data map[string]interface{}) (map[string]interface{}, error) {
+	row, err := MapToRow(data)
+	if err != nil {
+		return nil, err
+	}
+	return RowToMap(row), nil
+}
+
+// jsonToGo maps a value decoded with json.Decoder.UseNumber onto the types
+// ValueToGo produces, so JSON-RPC rows match the proto backends: whole
+// numbers become int64 and other numbers float64
+func jsonToGo(v interface{}) interface{} {
+	switch val := v.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	case []interface{}:
+		for i, item := range val {
+			val[i] = jsonToGo(item)
+		}
+		return val
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = jsonToGo(item)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// rowsFromJSON normalizes JSON-RPC query rows in place
+func rowsFromJSON(rows []map[string]interface{}) []map[string]interface{} {
+	if rows == nil {
+		return make([]map[string]interface{}, 0)
+	}
+	for _, row := range rows {
+		for k, v := range row {
+			row[k] = jsonToGo(v)
+		}
+	}
+	return rows
+}
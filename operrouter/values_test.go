@@ -0,0 +1,170 @@
+package operrouter
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/operrouter/go-operrouter/gen/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// point encodes itself as an object through ValueMarshaler
+type point struct{ x, y int64 }
+
+func (p point) MarshalValue() (*pb.Value, error) {
+	return &pb.Value{Value: &pb.Value_ObjectValue{ObjectValue: &pb.ValueObject{Fields: map[string]*pb.Value{
+		"x": {Value: &pb.Value_IntValue{IntValue: p.x}},
+		"y": {Value: &pb.Value_IntValue{IntValue: p.y}},
+	}}}}, nil
+}
+
+func TestGoToValueRoundTrip(t *testing.T) {
+	when := time.Date(2024, 3, 1, 12, 30, 0, 500, time.FixedZone("CET", 3600))
+	str := "hello"
+	var nilPtr *string
+	var nilMap map[string]interface{}
+
+	tests := []struct {
+		name string
+		in   interface{}
+		want interface{}
+	}{
+		{"nil", nil, nil},
+		{"bool", true, true},
+		{"int", 42, int64(42)},
+		{"int8", int8(-8), int64(-8)},
+		{"uint32", uint32(7), int64(7)},
+		{"float32", float32(1.5), float64(1.5)},
+		{"float64", 3.25, 3.25},
+		{"string", "text", "text"},
+		{"bytes", []byte{1, 2, 3}, []byte{1, 2, 3}},
+		{"byte array", [2]byte{4, 5}, []byte{4, 5}},
+		{"pointer", &str, "hello"},
+		{"nil pointer", nilPtr, nil},
+		{"nil map", nilMap, nil},
+		{"time", when, "2024-03-01T12:30:00.0000005+01:00"},
+		{"json int", json.Number("12"), int64(12)},
+		{"json float", json.Number("1.25"), 1.25},
+		{"null string", sql.NullString{String: "s", Valid: true}, "s"},
+		{"null string invalid", sql.NullString{}, nil},
+		{"null int", sql.NullInt64{Int64: 9, Valid: true}, int64(9)},
+		{"null time", sql.NullTime{Time: when, Valid: true}, "2024-03-01T12:30:00.0000005+01:00"},
+		{"marshaler", point{1, 2}, map[string]interface{}{"x": int64(1), "y": int64(2)}},
+		{
+			"nested slice",
+			[]interface{}{1, "a", []int{2, 3}, nil},
+			[]interface{}{int64(1), "a", []interface{}{int64(2), int64(3)}, nil},
+		},
+		{
+			"nested map",
+			map[string]interface{}{
+				"tags":  []string{"a", "b"},
+				"inner": map[string]int{"n": 1},
+				"when":  when,
+			},
+			map[string]interface{}{
+				"tags":  []interface{}{"a", "b"},
+				"inner": map[string]interface{}{"n": int64(1)},
+				"when":  "2024-03-01T12:30:00.0000005+01:00",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := GoToValue(tt.in)
+			if err != nil {
+				t.Fatalf("GoToValue(%#v): %v", tt.in, err)
+			}
+			if got := ValueToGo(v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValueToGo(GoToValue(%#v)) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGoToValueErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+	}{
+		{"uint overflow", uint64(math.MaxInt64) + 1},
+		{"int map key", map[int]string{1: "a"}},
+		{"channel", make(chan int)},
+		{"bad json number", json.Number("abc")},
+		{"json number out of range", json.Number("1e400")},
+		{"nested unsupported", map[string]interface{}{"f": func() {}}},
+		{"unsupported in slice", []interface{}{1, struct{}{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if v, err := GoToValue(tt.in); err == nil {
+				t.Errorf("GoToValue(%#v) = %v, want error", tt.in, v)
+			}
+		})
+	}
+}
+
+func TestGoToValuePassesProtoValues(t *testing.T) {
+	v := &pb.Value{Value: &pb.Value_StringValue{StringValue: "raw"}}
+	got, err := GoToValue(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, v) {
+		t.Errorf("GoToValue(%v) = %v, want it unchanged", v, got)
+	}
+}
+
+func TestValueToGo(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.Value
+		want interface{}
+	}{
+		{"nil", nil, nil},
+		{"unset", &pb.Value{}, nil},
+		{"null", nullValue(), nil},
+		{"int", &pb.Value{Value: &pb.Value_IntValue{IntValue: -3}}, int64(-3)},
+		{"empty array", &pb.Value{Value: &pb.Value_ArrayValue{ArrayValue: &pb.ValueArray{}}}, []interface{}{}},
+		{"empty object", &pb.Value{Value: &pb.Value_ObjectValue{ObjectValue: &pb.ValueObject{}}}, map[string]interface{}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValueToGo(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValueToGo(%v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRowsFromJSON(t *testing.T) {
+	var rows []map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(`[{"n": 1, "f": 1.5, "list": [2, {"m": 3}], "s": "x", "b": true, "z": null}]`))
+	dec.UseNumber()
+	if err := dec.Decode(&rows); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]interface{}{{
+		"n":    int64(1),
+		"f":    1.5,
+		"list": []interface{}{int64(2), map[string]interface{}{"m": int64(3)}},
+		"s":    "x",
+		"b":    true,
+		"z":    nil,
+	}}
+	if got := rowsFromJSON(rows); !reflect.DeepEqual(got, want) {
+		t.Errorf("rowsFromJSON = %#v, want %#v", got, want)
+	}
+	if got := rowsFromJSON(nil); got == nil || len(got) != 0 {
+		t.Errorf("rowsFromJSON(nil) = %#v, want an empty slice", got)
+	}
+}
@@ -0,0 +1,242 @@
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+// conn runs statements on one DataSource. Connections share the client and
+// hold no server-side state apart from an open transaction.
+type conn struct {
+	client     operrouter.Client
+	datasource string
+	// owned is set when the connection created client and must close it
+	owned bool
+
+	tx *operrouter.Tx
+}
+
+var (
+	_ driver.Conn               = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+	_ driver.NamedValueChecker  = (*conn)(nil)
+)
+
+// Prepare returns a statement; statements are not prepared on the server
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext returns a statement; statements are not prepared on the server
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return &stmt{conn: c, query: query}, nil
+}
+
+// Close releases the connection, rolling back a transaction left open
+func (c *conn) Close() error {
+	var err error
+	if c.tx != nil {
+		err = c.tx.Rollback()
+		c.tx = nil
+	}
+	if c.owned {
+		err = errors.Join(err, c.client.Close())
+	}
+	return err
+}
+
+// Begin starts a transaction
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx starts a transaction with the given isolation level
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.tx != nil {
+		return nil, fmt.Errorf("operrouter: transaction already in progress")
+	}
+	tx, err := c.client.BeginTx(ctx, c.datasource, &sql.TxOptions{
+		Isolation: sql.IsolationLevel(opts.Isolation),
+		ReadOnly:  opts.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.tx = tx
+	return &connTx{conn: c}, nil
+}
+
+// QueryContext runs a query, inside the open transaction if there is one
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	params := bindArgs(args)
+
+	var resp *operrouter.DataSourceQueryResponse
+	var err error
+	if c.tx != nil {
+		resp, err = c.tx.Query(ctx, query, params...)
+	} else {
+		resp, err = c.client.QueryDataSource(ctx, c.datasource, query, params...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("operrouter: query failed: %s", resp.Message)
+	}
+	return newRows(resp)
+}
+
+// ExecContext runs a statement, inside the open transaction if there is one
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	params := bindArgs(args)
+
+	var resp *operrouter.DataSourceExecuteResponse
+	var err error
+	if c.tx != nil {
+		resp, err = c.tx.Execute(ctx, query, params...)
+	} else {
+		resp, err = c.client.ExecuteDataSource(ctx, c.datasource, query, params...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("operrouter: exec failed: %s", resp.Message)
+	}
+	return &result{affected: resp.AffectedRows, lastInsertID: resp.LastInsertID}, nil
+}
+
+// Ping checks the DataSource
+func (c *conn) Ping(ctx context.Context) error {
+	resp, err := c.client.PingDataSource(ctx, c.datasource)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("operrouter: ping failed: %s", resp.Message)
+	}
+	return nil
+}
+
+// CheckNamedValue accepts every value operrouter.GoToValue can encode, so
+// slices, maps and ValueMarshaler types reach the server unchanged
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	_, err := operrouter.GoToValue(nv.Value)
+	return err
+}
+
+// bindArgs turns driver arguments into QueryDataSource arguments
+func bindArgs(args []driver.NamedValue) []interface{} {
+	params := make([]interface{}, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			params[i] = sql.Named(arg.Name, arg.Value)
+		} else {
+			params[i] = arg.Value
+		}
+	}
+	return params
+}
+
+// stmt is a statement bound to a connection
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+var (
+	_ driver.StmtQueryContext = (*stmt)(nil)
+	_ driver.StmtExecContext  = (*stmt)(nil)
+)
+
+// Close does nothing: statements hold no server-side resources
+func (s *stmt) Close() error {
+	return nil
+}
+
+// NumInput returns -1: the number of placeholders is not known
+func (s *stmt) NumInput() int {
+	return -1
+}
+
+// Exec runs the statement
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+// Query runs the statement as a query
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+// ExecContext runs the statement
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+// QueryContext runs the statement as a query
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+// namedValues converts positional driver values
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+// connTx ends the connection's transaction
+type connTx struct {
+	conn *conn
+}
+
+// Commit commits the transaction
+func (t *connTx) Commit() error {
+	tx := t.conn.tx
+	if tx == nil {
+		return operrouter.ErrTxDone
+	}
+	t.conn.tx = nil
+	return tx.Commit()
+}
+
+// Rollback aborts the transaction
+func (t *connTx) Rollback() error {
+	tx := t.conn.tx
+	if tx == nil {
+		return operrouter.ErrTxDone
+	}
+	t.conn.tx = nil
+	return tx.Rollback()
+}
+
+// result reports the outcome of ExecContext
+type result struct {
+	affected     uint64
+	lastInsertID *int64
+}
+
+// LastInsertId returns the generated id when the server reports one
+func (r *result) LastInsertId() (int64, error) {
+	if r.lastInsertID == nil {
+		return 0, fmt.Errorf("operrouter: LastInsertId is not reported by this DataSource")
+	}
+	return *r.lastInsertID, nil
+}
+
+// RowsAffected returns the number of rows changed
+func (r *result) RowsAffected() (int64, error) {
+	return int64(r.affected), nil
+}
@@ -0,0 +1,157 @@
+// Package sqldriver registers the "operrouter" database/sql driver, which runs
+// SQL on an OperRouter DataSource through any operrouter.Client.
+//
+// The DSN names the endpoint and the DataSource:
+//
+//	http://localhost:8080?datasource=my_db
+//	grpc://localhost:50051?datasource=my_db&timeout=5s
+//	ffi:///usr/lib/liboperrouter_core_ffi.so?datasource=my_db
+//
+// To reuse an existing client, use sql.OpenDB(sqldriver.NewConnector(client, "my_db")).
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+func init() {
+	sql.Register("operrouter", &Driver{})
+}
+
+// Driver is the "operrouter" database/sql driver
+type Driver struct{}
+
+// Open opens a connection with its own client, closed with the connection.
+// database/sql prefers OpenConnector, which shares one client between connections.
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	cfg, err := parseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	client, err := cfg.openClient()
+	if err != nil {
+		return nil, err
+	}
+	return &conn{client: client, datasource: cfg.datasource, owned: true}, nil
+}
+
+// OpenConnector parses dsn and creates the client used by every connection
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	cfg, err := parseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	client, err := cfg.openClient()
+	if err != nil {
+		return nil, err
+	}
+	return &connector{driver: d, client: client, datasource: cfg.datasource, owned: true}, nil
+}
+
+// NewConnector returns a connector running statements on datasource through
+// client. Closing the sql.DB does not close client.
+func NewConnector(client operrouter.Client, datasource string) driver.Connector {
+	return &connector{driver: &Driver{}, client: client, datasource: datasource}
+}
+
+// connector hands out connections sharing one client
+type connector struct {
+	driver     *Driver
+	client     operrouter.Client
+	datasource string
+	// owned is set when the connector created client and must close it
+	owned bool
+}
+
+// Connect returns a new connection; it holds no server-side resources
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &conn{client: c.client, datasource: c.datasource}, nil
+}
+
+// Driver returns the underlying Driver
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
+
+// Close closes the client when the connector created it; sql.DB.Close calls it
+func (c *connector) Close() error {
+	if c.owned {
+		return c.client.Close()
+	}
+	return nil
+}
+
+// dsnConfig is a parsed DSN
+type dsnConfig struct {
+	scheme     string
+	address    string
+	datasource string
+	timeout    time.Duration
+}
+
+// parseDSN reads "<scheme>://<address>?datasource=<name>[&timeout=<duration>]"
+func parseDSN(dsn string) (*dsnConfig, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("operrouter: invalid dsn: %w", err)
+	}
+
+	query := u.Query()
+	cfg := &dsnConfig{
+		scheme:     u.Scheme,
+		datasource: query.Get("datasource"),
+	}
+	if cfg.datasource == "" {
+		return nil, fmt.Errorf("operrouter: invalid dsn: datasource is required")
+	}
+	if timeout := query.Get("timeout"); timeout != "" {
+		if cfg.timeout, err = time.ParseDuration(timeout); err != nil {
+			return nil, fmt.Errorf("operrouter: invalid dsn: timeout: %w", err)
+		}
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return nil, fmt.Errorf("operrouter: invalid dsn: missing host")
+		}
+		cfg.address = (&url.URL{Scheme: u.Scheme, User: u.User, Host: u.Host, Path: u.Path}).String()
+	case "grpc":
+		if u.Host == "" {
+			return nil, fmt.Errorf("operrouter: invalid dsn: missing host")
+		}
+		cfg.address = u.Host
+	case "ffi":
+		if u.Path == "" {
+			return nil, fmt.Errorf("operrouter: invalid dsn: missing library path")
+		}
+		cfg.address = u.Path
+	default:
+		return nil, fmt.Errorf("operrouter: invalid dsn: unsupported scheme %q (want http, https, grpc or ffi)", u.Scheme)
+	}
+	return cfg, nil
+}
+
+// openClient creates the client for the DSN's backend
+func (cfg *dsnConfig) openClient() (operrouter.Client, error) {
+	var opts []operrouter.ClientOption
+	if cfg.timeout > 0 {
+		opts = append(opts, operrouter.WithTimeout(cfg.timeout))
+	}
+
+	switch cfg.scheme {
+	case "grpc":
+		return operrouter.NewGRPC(cfg.address, opts...)
+	case "ffi":
+		return openFFI(cfg.address, opts...)
+	default:
+		return operrouter.NewHTTPWithOptions(cfg.address, opts...)
+	}
+}
@@ -0,0 +1,144 @@
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+func TestParseDSN(t *testing.T) {
+	tests := []struct {
+		dsn  string
+		want dsnConfig
+		err  string
+	}{
+		{
+			dsn:  "http://localhost:8080?datasource=my_db",
+			want: dsnConfig{scheme: "http", address: "http://localhost:8080", datasource: "my_db"},
+		},
+		{
+			dsn:  "https://user:pw@router.example.com/api?datasource=db&timeout=250ms",
+			want: dsnConfig{scheme: "https", address: "https://user:pw@router.example.com/api", datasource: "db", timeout: 250 * time.Millisecond},
+		},
+		{
+			dsn:  "grpc://localhost:50051?datasource=my%20db&timeout=5s",
+			want: dsnConfig{scheme: "grpc", address: "localhost:50051", datasource: "my db", timeout: 5 * time.Second},
+		},
+		{
+			dsn:  "ffi:///usr/lib/liboperrouter_core_ffi.so?datasource=my_db",
+			want: dsnConfig{scheme: "ffi", address: "/usr/lib/liboperrouter_core_ffi.so", datasource: "my_db"},
+		},
+		{dsn: "http://localhost:8080", err: "datasource is required"},
+		{dsn: "http://localhost:8080?datasource=db&timeout=soon", err: "timeout"},
+		{dsn: "http:///path?datasource=db", err: "missing host"},
+		{dsn: "grpc://?datasource=db", err: "missing host"},
+		{dsn: "ffi://?datasource=db", err: "missing library path"},
+		{dsn: "postgres://localhost/app?datasource=db", err: `unsupported scheme "postgres"`},
+		{dsn: "http://[::1?datasource=db", err: "invalid dsn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dsn, func(t *testing.T) {
+			cfg, err := parseDSN(tt.dsn)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("parseDSN error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDSN: %v", err)
+			}
+			if *cfg != tt.want {
+				t.Errorf("parseDSN = %+v, want %+v", *cfg, tt.want)
+			}
+		})
+	}
+}
+
+// fakeClient answers queries with a fixed result and records statements
+type fakeClient struct {
+	operrouter.Client
+	query    *operrouter.DataSourceQueryResponse
+	executed []interface{}
+	closed   bool
+}
+
+func (c *fakeClient) QueryDataSource(ctx context.Context, name string, query string, args ...interface{}) (*operrouter.DataSourceQueryResponse, error) {
+	return c.query, nil
+}
+
+func (c *fakeClient) ExecuteDataSource(ctx context.Context, name string, query string, args ...interface{}) (*operrouter.DataSourceExecuteResponse, error) {
+	c.executed = append(c.executed, args...)
+	id := int64(42)
+	return &operrouter.DataSourceExecuteResponse{Success: true, AffectedRows: 2, LastInsertID: &id}, nil
+}
+
+func (c *fakeClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestConnector(t *testing.T) {
+	client := &fakeClient{query: &operrouter.DataSourceQueryResponse{
+		Success: true,
+		Columns: []string{"id", "name", "tags"},
+		Rows: []map[string]interface{}{
+			{"id": int64(1), "name": "a", "tags": []interface{}{"x"}},
+			{"id": int64(2), "name": nil, "tags": nil},
+		},
+	}}
+	db := sql.OpenDB(NewConnector(client, "db"))
+
+	rows, err := db.Query("SELECT id, name, tags FROM users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type user struct {
+		id   int64
+		name sql.NullString
+		tags []byte
+	}
+	var got []user
+	for rows.Next() {
+		var u user
+		if err := rows.Scan(&u.id, &u.name, &u.tags); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, u)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []user{
+		{1, sql.NullString{String: "a", Valid: true}, []byte(`["x"]`)},
+		{2, sql.NullString{}, nil},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %+v, want %+v", got, want)
+	}
+
+	res, err := db.Exec("UPDATE users SET name = :name WHERE id = ?", sql.Named("name", "b"), []string{"list"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := res.RowsAffected(); n != 2 {
+		t.Errorf("RowsAffected = %d, want 2", n)
+	}
+	if id, _ := res.LastInsertId(); id != 42 {
+		t.Errorf("LastInsertId = %d, want 42", id)
+	}
+	wantArgs := []interface{}{sql.Named("name", "b"), []string{"list"}}
+	if !reflect.DeepEqual(client.executed, wantArgs) {
+		t.Errorf("arguments = %#v, want %#v", client.executed, wantArgs)
+	}
+
+	if err := db.Close(); err != nil || client.closed {
+		t.Errorf("Close = %v, closed client %v: want a shared client left open", err, client.closed)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build cgo
+// +build cgo
+
+package sqldriver
+
+import "github.com/operrouter/go-operrouter/operrouter"
+
+// openFFI loads the OperRouter shared library at path
+func openFFI(path string, opts ...operrouter.ClientOption) (operrouter.Client, error) {
+	return operrouter.NewFFI(path, opts...)
+}
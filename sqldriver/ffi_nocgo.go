@@ -0,0 +1,15 @@
+//go:build !cgo
+// +build !cgo
+
+package sqldriver
+
+import (
+	"fmt"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+// openFFI fails: the FFI backend needs cgo
+func openFFI(path string, opts ...operrouter.ClientOption) (operrouter.Client, error) {
+	return nil, fmt.Errorf("operrouter: ffi dsn requires a cgo build")
+}
@@ -0,0 +1,136 @@
+package sqldriver
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+// rows serves a query result already held in memory
+type rows struct {
+	columns []string
+	data    []map[string]interface{}
+	next    int
+}
+
+var (
+	_ driver.Rows                           = (*rows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*rows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*rows)(nil)
+)
+
+// newRows orders the columns as the server reports them. Without that list
+// the order is only known for a single column, since rows are maps.
+func newRows(resp *operrouter.DataSourceQueryResponse) (*rows, error) {
+	columns := resp.Columns
+	if len(columns) == 0 {
+		seen := make(map[string]bool)
+		for _, row := range resp.Rows {
+			for column := range row {
+				if !seen[column] {
+					seen[column] = true
+					columns = append(columns, column)
+				}
+			}
+		}
+		if len(columns) > 1 {
+			return nil, fmt.Errorf("operrouter: query result has %d columns but the server did not report their order", len(columns))
+		}
+	}
+	return &rows{columns: columns, data: resp.Rows}, nil
+}
+
+// Columns returns the column names
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+// Close releases the rows
+func (r *rows) Close() error {
+	r.data = nil
+	return nil
+}
+
+// Next copies the next row into dest
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.data) {
+		return io.EOF
+	}
+	row := r.data[r.next]
+	r.next++
+
+	for i, column := range r.columns {
+		value, err := driverValue(row[column])
+		if err != nil {
+			return err
+		}
+		dest[i] = value
+	}
+	return nil
+}
+
+// ColumnTypeScanType returns the Go type of the column's first non-NULL value
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	switch r.columnType(index) {
+	case "BOOL":
+		return reflect.TypeOf(false)
+	case "INT":
+		return reflect.TypeOf(int64(0))
+	case "FLOAT":
+		return reflect.TypeOf(float64(0))
+	case "STRING":
+		return reflect.TypeOf("")
+	case "BYTES", "ARRAY", "OBJECT":
+		return reflect.TypeOf([]byte(nil))
+	default:
+		return reflect.TypeOf((*interface{})(nil)).Elem()
+	}
+}
+
+// ColumnTypeDatabaseTypeName names the pb.Value variant of the column:
+// BOOL, INT, FLOAT, STRING, BYTES, ARRAY or OBJECT, or "" when every value is NULL
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.columnType(index)
+}
+
+// columnType finds the pb.Value variant of a column from its values
+func (r *rows) columnType(index int) string {
+	column := r.columns[index]
+	for _, row := range r.data {
+		switch row[column].(type) {
+		case nil:
+			continue
+		case bool:
+			return "BOOL"
+		case int64:
+			return "INT"
+		case float64:
+			return "FLOAT"
+		case string:
+			return "STRING"
+		case []byte:
+			return "BYTES"
+		case []interface{}:
+			return "ARRAY"
+		case map[string]interface{}:
+			return "OBJECT"
+		}
+	}
+	return ""
+}
+
+// driverValue converts a value from operrouter.ValueToGo into a driver.Value.
+// Arrays and objects are returned as JSON text.
+func driverValue(v interface{}) (driver.Value, error) {
+	switch val := v.(type) {
+	case nil, bool, int64, float64, string, []byte, time.Time:
+		return val, nil
+	default:
+		return json.Marshal(val)
+	}
+}
@@ -0,0 +1,132 @@
+package sqldriver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/operrouter/go-operrouter/operrouter"
+)
+
+func TestNewRows(t *testing.T) {
+	tests := []struct {
+		name string
+		resp operrouter.DataSourceQueryResponse
+		want []string
+		err  string
+	}{
+		{
+			"server order",
+			operrouter.DataSourceQueryResponse{Columns: []string{"b", "a"}, Rows: []map[string]interface{}{{"a": int64(1), "b": int64(2)}}},
+			[]string{"b", "a"},
+			"",
+		},
+		{
+			"server order without rows",
+			operrouter.DataSourceQueryResponse{Columns: []string{"id", "name"}},
+			[]string{"id", "name"},
+			"",
+		},
+		{
+			"single column without order",
+			operrouter.DataSourceQueryResponse{Rows: []map[string]interface{}{{"count": int64(3)}}},
+			[]string{"count"},
+			"",
+		},
+		{"empty result", operrouter.DataSourceQueryResponse{}, nil, ""},
+		{
+			"several columns without order",
+			operrouter.DataSourceQueryResponse{Rows: []map[string]interface{}{{"a": int64(1)}, {"b": int64(2)}}},
+			nil,
+			"2 columns but the server did not report their order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newRows(&tt.resp)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("newRows error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newRows: %v", err)
+			}
+			if got := r.Columns(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Columns = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRowsNext(t *testing.T) {
+	when := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	r, err := newRows(&operrouter.DataSourceQueryResponse{
+		Columns: []string{"id", "ok", "score", "name", "data", "tags", "doc", "at", "missing"},
+		Rows: []map[string]interface{}{
+			{
+				"id": int64(1), "ok": true, "score": 1.5, "name": "a", "data": []byte{1},
+				"tags": []interface{}{"x", int64(2)}, "doc": map[string]interface{}{"k": "v"}, "at": when,
+			},
+			{"id": int64(2)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]driver.Value{
+		{int64(1), true, 1.5, "a", []byte{1}, []byte(`["x",2]`), []byte(`{"k":"v"}`), when, nil},
+		{int64(2), nil, nil, nil, nil, nil, nil, nil, nil},
+	}
+	for i, wantRow := range want {
+		dest := make([]driver.Value, len(r.Columns()))
+		if err := r.Next(dest); err != nil {
+			t.Fatalf("Next %d: %v", i, err)
+		}
+		if !reflect.DeepEqual(dest, wantRow) {
+			t.Errorf("row %d = %#v, want %#v", i, dest, wantRow)
+		}
+	}
+	if err := r.Next(make([]driver.Value, len(r.Columns()))); !errors.Is(err, io.EOF) {
+		t.Errorf("Next after the last row = %v, want io.EOF", err)
+	}
+}
+
+func TestRowsColumnTypes(t *testing.T) {
+	r, err := newRows(&operrouter.DataSourceQueryResponse{
+		Columns: []string{"id", "name", "doc", "empty"},
+		Rows: []map[string]interface{}{
+			{"id": nil, "name": "a", "doc": map[string]interface{}{}},
+			{"id": int64(2), "name": "b"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		index    int
+		name     string
+		scanType reflect.Type
+	}{
+		{0, "INT", reflect.TypeOf(int64(0))},
+		{1, "STRING", reflect.TypeOf("")},
+		{2, "OBJECT", reflect.TypeOf([]byte(nil))},
+		{3, "", reflect.TypeOf((*interface{})(nil)).Elem()},
+	}
+	for _, tt := range tests {
+		if got := r.ColumnTypeDatabaseTypeName(tt.index); got != tt.name {
+			t.Errorf("ColumnTypeDatabaseTypeName(%d) = %q, want %q", tt.index, got, tt.name)
+		}
+		if got := r.ColumnTypeScanType(tt.index); got != tt.scanType {
+			t.Errorf("ColumnTypeScanType(%d) = %v, want %v", tt.index, got, tt.scanType)
+		}
+	}
+}